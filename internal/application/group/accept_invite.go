@@ -0,0 +1,83 @@
+package group
+
+import (
+	"context"
+	"time"
+
+	appUser "reading-cats-api/internal/application/user"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type AcceptInviteUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	clock    func() time.Time
+}
+
+func NewAcceptInviteUseCase(repo Repository, userRepo appUser.Repository) *AcceptInviteUseCase {
+	return &AcceptInviteUseCase{repo: repo, userRepo: userRepo, clock: time.Now}
+}
+
+func (uc *AcceptInviteUseCase) Execute(ctx context.Context, in AcceptInviteInput) (AcceptInviteOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return AcceptInviteOutput{}, err
+	}
+	if user == nil {
+		return AcceptInviteOutput{}, ErrUserNotFound
+	}
+
+	var out AcceptInviteOutput
+
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		inv, err := uc.repo.LockInviteByCode(ctx, tx, in.Code)
+		if err != nil {
+			return err
+		}
+		if inv == nil {
+			return ErrInviteNotFound
+		}
+
+		// trava o grupo: joins concorrentes esperam aqui e enxergam a contagem atualizada
+		g, err := uc.repo.LockGroup(ctx, tx, inv.GroupID)
+		if err != nil {
+			return err
+		}
+		if g == nil {
+			return ErrInviteNotFound
+		}
+		out.Group = toGroupOutput(g)
+
+		// quem já é membro ativo não consome o convite
+		member, err := uc.repo.GetMember(ctx, g.ID, user.ID)
+		if err != nil {
+			return err
+		}
+		if member != nil && member.IsActive {
+			out.AlreadyMember = true
+			return nil
+		}
+
+		now := uc.clock()
+		if err := inv.CheckUsable(now); err != nil {
+			return err
+		}
+
+		joined, err := joinGroup(ctx, uc.repo, tx, g, user.ID, now)
+		if err != nil {
+			return err
+		}
+		if !joined {
+			out.AlreadyMember = true
+			return nil
+		}
+
+		return uc.repo.IncrementInviteUses(ctx, tx, inv.ID)
+	})
+	if err != nil {
+		return AcceptInviteOutput{}, err
+	}
+
+	return out, nil
+}
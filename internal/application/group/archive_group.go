@@ -0,0 +1,86 @@
+package group
+
+import (
+	"context"
+	"time"
+
+	appUser "reading-cats-api/internal/application/user"
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ArchiveGroupUseCase struct {
+	repo      Repository
+	userRepo  appUser.Repository
+	policy    *Policy
+	finalizer SeasonFinalizer
+	clock     func() time.Time
+}
+
+func NewArchiveGroupUseCase(repo Repository, userRepo appUser.Repository, policy *Policy, finalizer SeasonFinalizer) *ArchiveGroupUseCase {
+	return &ArchiveGroupUseCase{repo: repo, userRepo: userRepo, policy: policy, finalizer: finalizer, clock: time.Now}
+}
+
+// Execute congela o grupo: encerra a season ativa e impede convites, entradas,
+// novas seasons e edições. O grupo continua legível pra manter o histórico.
+func (uc *ArchiveGroupUseCase) Execute(ctx context.Context, in ArchiveGroupInput) (CreateGroupOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return CreateGroupOutput{}, err
+	}
+	if user == nil {
+		return CreateGroupOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanEditGroup(ctx, in.GroupID, user.ID); err != nil {
+		return CreateGroupOutput{}, err
+	}
+
+	var out CreateGroupOutput
+
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		g, err := uc.repo.LockGroup(ctx, tx, in.GroupID)
+		if err != nil {
+			return err
+		}
+		if g == nil {
+			return ErrGroupNotFound
+		}
+
+		now := uc.clock().UTC()
+		if err := g.Archive(now); err != nil {
+			return err
+		}
+
+		seasonID, ended, err := uc.repo.EndActiveSeason(ctx, tx, g.ID, now)
+		if err != nil {
+			return err
+		}
+		if ended {
+			if err := uc.finalizer.FinalizeSeason(ctx, tx, seasonID); err != nil {
+				return err
+			}
+			e := newEvent(g.ID, domainGroup.EventSeasonEnded, user.ID).
+				WithSeason(seasonID).
+				With("reason", "GROUP_ARCHIVED")
+			if err := recordEvent(ctx, uc.repo, tx, e); err != nil {
+				return err
+			}
+		}
+
+		updatedAt, err := uc.repo.Archive(ctx, tx, g.ID, now)
+		if err != nil {
+			return err
+		}
+		g.UpdatedAt = updatedAt
+
+		out = toGroupOutput(g)
+		return nil
+	})
+	if err != nil {
+		return CreateGroupOutput{}, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,77 @@
+package group
+
+import (
+	"context"
+
+	appUser "reading-cats-api/internal/application/user"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ChangeMemberRoleUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+}
+
+func NewChangeMemberRoleUseCase(repo Repository, userRepo appUser.Repository, policy *Policy) *ChangeMemberRoleUseCase {
+	return &ChangeMemberRoleUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+func (uc *ChangeMemberRoleUseCase) Execute(ctx context.Context, in ChangeMemberRoleInput) (MemberRoleOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return MemberRoleOutput{}, err
+	}
+	if user == nil {
+		return MemberRoleOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanManageMembers(ctx, in.GroupID, user.ID); err != nil {
+		return MemberRoleOutput{}, err
+	}
+
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// o lock no grupo serializa trocas de papel concorrentes (ex.: dois admins se rebaixando)
+		g, err := uc.repo.LockGroup(ctx, tx, in.GroupID)
+		if err != nil {
+			return err
+		}
+		if g == nil {
+			return ErrGroupNotFound
+		}
+		if err := g.EnsureNotArchived(); err != nil {
+			return err
+		}
+
+		target, err := uc.repo.GetMember(ctx, g.ID, in.UserID)
+		if err != nil {
+			return err
+		}
+		if target == nil || !target.IsActive {
+			return ErrMemberNotFound
+		}
+		if target.Role == in.Role {
+			return nil
+		}
+
+		admins, err := uc.repo.CountActiveAdmins(ctx, tx, g.ID)
+		if err != nil {
+			return err
+		}
+		if err := target.ChangeRole(in.Role, g, admins); err != nil {
+			return err
+		}
+
+		return uc.repo.UpdateMemberRole(ctx, tx, g.ID, target.UserID, target.Role)
+	})
+	if err != nil {
+		return MemberRoleOutput{}, err
+	}
+
+	return MemberRoleOutput{
+		GroupID: in.GroupID,
+		UserID:  in.UserID,
+		Role:    in.Role.String(),
+	}, nil
+}
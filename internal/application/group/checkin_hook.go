@@ -0,0 +1,121 @@
+package group
+
+import (
+	"context"
+	"log"
+	"time"
+
+	domainGroup "reading-cats-api/internal/domain/group"
+	readingDomain "reading-cats-api/internal/domain/reading"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CheckinHook leva o registro de leitura pessoal pros grupos do usuário: conta o dia
+// nas seasons ativas (group_checkins) e alimenta o feed.
+// Roda na transação do RegisterReadingUseCase (implementa reading.CheckinHook).
+type CheckinHook struct {
+	repo Repository
+}
+
+func NewCheckinHook(repo Repository) *CheckinHook {
+	return &CheckinHook{repo: repo}
+}
+
+func (h *CheckinHook) AfterCheckin(ctx context.Context, tx pgx.Tx, c readingDomain.Checkin) error {
+	if err := h.fanOut(ctx, tx, c); err != nil {
+		return err
+	}
+
+	// só o primeiro registro do dia vira evento; os seguintes só somam páginas
+	if !c.NewDay {
+		return nil
+	}
+	return h.recordCheckinEvents(ctx, tx, c)
+}
+
+// fanOut grava um group_checkins por season ativa em que o dia se qualifica, com o dia
+// calculado no fuso da season.
+func (h *CheckinHook) fanOut(ctx context.Context, tx pgx.Tx, c readingDomain.Checkin) error {
+	seasons, err := h.repo.ListActiveSeasonsByUser(ctx, tx, c.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range seasons {
+		// reavaliado a cada registro: um dia que não bateu o mínimo pode passar a contar
+		// mais tarde (InsertCheckin ignora o dia que já conta)
+		if !s.Counts(c) {
+			continue
+		}
+		gc, err := s.CheckinFor(c)
+		if err != nil {
+			// fuso inválido numa season não pode impedir o registro pessoal nem os outros grupos
+			log.Printf("[group] skipping season %s for checkin %s: %v", s.ID, c.UserCheckinID, err)
+			continue
+		}
+		if _, err := h.repo.InsertCheckin(ctx, tx, gc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *CheckinHook) recordCheckinEvents(ctx context.Context, tx pgx.Tx, c readingDomain.Checkin) error {
+	groupIDs, err := h.repo.ListActiveGroupIDsByUser(ctx, tx, c.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, groupID := range groupIDs {
+		e := newEvent(groupID, domainGroup.EventMemberCheckedIn, c.UserID).
+			With("local_date", c.Date.String())
+		if err := recordEvent(ctx, h.repo, tx, e); err != nil {
+			return err
+		}
+
+		if c.StreakDays.IsMilestone() {
+			e := newEvent(groupID, domainGroup.EventStreakMilestone, c.UserID).
+				With("streak_days", int(c.StreakDays))
+			if err := recordEvent(ctx, h.repo, tx, e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// EnsureEditable implementa reading.CheckinEditGuard: o dia só é corrigível se todas as
+// seasons que o contaram ainda estão dentro da janela. Dia fora de season usa a janela padrão.
+func (h *CheckinHook) EnsureEditable(ctx context.Context, tx pgx.Tx, userCheckinID string, loggedAt time.Time, now time.Time) error {
+	seasons, err := h.repo.ListSeasonsByUserCheckin(ctx, tx, userCheckinID)
+	if err != nil {
+		return err
+	}
+	if len(seasons) == 0 {
+		return readingDomain.EnsureEditable(loggedAt, now, readingDomain.DefaultEditWindow)
+	}
+
+	for _, s := range seasons {
+		if err := s.EnsureCheckinEditable(loggedAt, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterEdit reavalia o dia corrigido. Nas seasons em que o dia já entrou vale só a
+// qualificação: o dia pertence à season desde o registro (EnsureEditable já barrou as encerradas).
+// Nas seasons ativas em que ainda não entrou, segue a regra do registro (fanOut).
+func (h *CheckinHook) AfterEdit(ctx context.Context, tx pgx.Tx, c readingDomain.Checkin) error {
+	seasons, err := h.repo.ListSeasonsByUserCheckin(ctx, tx, c.UserCheckinID)
+	if err != nil {
+		return err
+	}
+	for _, s := range seasons {
+		if err := h.repo.SetCheckinQualified(ctx, tx, s.ID, c.UserCheckinID, s.Qualification.Qualifies(c)); err != nil {
+			return err
+		}
+	}
+	return h.fanOut(ctx, tx, c)
+}
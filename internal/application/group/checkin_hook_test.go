@@ -0,0 +1,193 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+	domainSeason "reading-cats-api/internal/domain/season"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// fakeCheckinRepo guarda group_checkins em memória com a mesma chave do banco
+// (season_id, user_checkin_id). Métodos fora do hook não são implementados.
+type fakeCheckinRepo struct {
+	Repository
+	seasons   map[string]domainSeason.Season
+	checkins  map[string]domainSeason.Checkin
+	qualified map[string]bool
+}
+
+func newFakeCheckinRepo(seasons ...domainSeason.Season) *fakeCheckinRepo {
+	f := &fakeCheckinRepo{
+		seasons:   map[string]domainSeason.Season{},
+		checkins:  map[string]domainSeason.Checkin{},
+		qualified: map[string]bool{},
+	}
+	for _, s := range seasons {
+		f.seasons[s.ID] = s
+	}
+	return f
+}
+
+func (f *fakeCheckinRepo) ListActiveSeasonsByUser(_ context.Context, _ pgx.Tx, _ string) ([]domainSeason.Season, error) {
+	out := []domainSeason.Season{}
+	for _, s := range f.seasons {
+		if s.Status == domainSeason.StatusActive {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeCheckinRepo) ListSeasonsByUserCheckin(_ context.Context, _ pgx.Tx, userCheckinID string) ([]domainSeason.Season, error) {
+	out := []domainSeason.Season{}
+	for _, gc := range f.checkins {
+		if gc.UserCheckinID == userCheckinID {
+			out = append(out, f.seasons[gc.SeasonID])
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeCheckinRepo) InsertCheckin(_ context.Context, _ pgx.Tx, c domainSeason.Checkin) (bool, error) {
+	key := c.SeasonID + "/" + c.UserCheckinID
+	if f.qualified[key] {
+		return false, nil
+	}
+	if _, ok := f.checkins[key]; !ok {
+		f.checkins[key] = c
+	}
+	f.qualified[key] = true
+	return true, nil
+}
+
+func (f *fakeCheckinRepo) SetCheckinQualified(_ context.Context, _ pgx.Tx, seasonID string, userCheckinID string, qualified bool) error {
+	key := seasonID + "/" + userCheckinID
+	if _, ok := f.checkins[key]; ok {
+		f.qualified[key] = qualified
+	}
+	return nil
+}
+
+func activeSeason(id string, metric domainSeason.Metric) domainSeason.Season {
+	started := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	return domainSeason.Season{
+		ID:        id,
+		GroupID:   "g1",
+		Status:    domainSeason.StatusActive,
+		StartedAt: &started,
+		Timezone:  domainSeason.Timezone("UTC"),
+		Metric:    metric,
+	}
+}
+
+func TestFanOutFilesEachPersonalDayUnderItsSeasonDate(t *testing.T) {
+	seasons := []domainSeason.Season{
+		activeSeason("utc", domainSeason.MetricCheckinsPerDay),
+		activeSeason("tokyo", domainSeason.MetricPages),
+	}
+	seasons[1].Timezone = domainSeason.Timezone("Asia/Tokyo")
+	repo := newFakeCheckinRepo(seasons...)
+	hook := NewCheckinHook(repo)
+
+	sp, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 20:00 em São Paulo já é o dia seguinte em UTC e em Tóquio; cada dia pessoal fica no seu dia
+	first := time.Date(2026, 3, 10, 20, 0, 0, 0, sp)
+	second := time.Date(2026, 3, 11, 20, 0, 0, 0, sp)
+	days := []readingDomain.Checkin{
+		{UserID: "u1", UserCheckinID: "day-1", Date: "2026-03-10", FirstLoggedAt: first, At: first, Pages: 10},
+		{UserID: "u1", UserCheckinID: "day-2", Date: "2026-03-11", FirstLoggedAt: second, At: second, Pages: 7},
+		// segundo registro do dia 2, já depois da meia-noite de São Paulo: mesma linha
+		{UserID: "u1", UserCheckinID: "day-2", Date: "2026-03-11", FirstLoggedAt: second, At: second.Add(4 * time.Hour), Pages: 12},
+	}
+	for _, c := range days {
+		if err := hook.AfterCheckin(context.Background(), nil, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := map[string]readingDomain.LocalDate{"day-1": "2026-03-10", "day-2": "2026-03-11"}
+	for _, s := range seasons {
+		for id, date := range want {
+			gc, ok := repo.checkins[s.ID+"/"+id]
+			if !ok {
+				t.Fatalf("season %s: %s not counted", s.ID, id)
+			}
+			if gc.LocalDate != date {
+				t.Errorf("season %s: %s on %s, want %s", s.ID, id, gc.LocalDate, date)
+			}
+		}
+	}
+	if len(repo.checkins) != len(seasons)*len(want) {
+		t.Errorf("got %d group checkins, want %d", len(repo.checkins), len(seasons)*len(want))
+	}
+}
+
+func TestEnsureEditableRefusesDayInEndedSeason(t *testing.T) {
+	s := activeSeason("s1", domainSeason.MetricPages)
+	s.EditWindow = readingDomain.DefaultEditWindow
+	repo := newFakeCheckinRepo(s)
+	hook := NewCheckinHook(repo)
+
+	at := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	day := readingDomain.Checkin{UserID: "u1", UserCheckinID: "day-1", Date: "2026-03-10", FirstLoggedAt: at, At: at, Pages: 12}
+	if err := hook.AfterCheckin(context.Background(), nil, day); err != nil {
+		t.Fatal(err)
+	}
+
+	// a season encerra ainda dentro da janela de correção do dia
+	ended := repo.seasons["s1"]
+	endsAt := at.Add(time.Minute)
+	ended.Status = domainSeason.StatusEnded
+	ended.EndsAt = &endsAt
+	repo.seasons["s1"] = ended
+
+	err := hook.EnsureEditable(context.Background(), nil, "day-1", at, at.Add(5*time.Minute))
+	if !errors.Is(err, readingDomain.ErrCheckinLocked) {
+		t.Fatalf("EnsureEditable = %v, want ErrCheckinLocked", err)
+	}
+}
+
+func TestAfterEditRequalifiesDayInActiveSeason(t *testing.T) {
+	s := activeSeason("s1", domainSeason.MetricPages)
+	s.EditWindow = readingDomain.DefaultEditWindow
+	s.Qualification = domainSeason.Qualification{MinPages: 10}
+	repo := newFakeCheckinRepo(s)
+	hook := NewCheckinHook(repo)
+
+	at := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	day := readingDomain.Checkin{UserID: "u1", UserCheckinID: "day-1", Date: "2026-03-10", FirstLoggedAt: at, At: at, Pages: 12}
+	if err := hook.AfterCheckin(context.Background(), nil, day); err != nil {
+		t.Fatal(err)
+	}
+	if err := hook.EnsureEditable(context.Background(), nil, "day-1", at, at.Add(5*time.Minute)); err != nil {
+		t.Fatalf("EnsureEditable = %v, want nil", err)
+	}
+
+	tests := []struct {
+		pages int
+		want  bool
+	}{
+		{5, false},
+		{15, true},
+	}
+	for _, tt := range tests {
+		day.Pages = tt.pages
+		if err := hook.AfterEdit(context.Background(), nil, day); err != nil {
+			t.Fatal(err)
+		}
+		if got := repo.qualified["s1/day-1"]; got != tt.want {
+			t.Fatalf("after edit to %d pages: qualified = %v, want %v", tt.pages, got, tt.want)
+		}
+	}
+	if len(repo.checkins) != 1 {
+		t.Errorf("got %d group checkins, want 1", len(repo.checkins))
+	}
+}
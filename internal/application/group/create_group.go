@@ -9,6 +9,7 @@ import (
 	domainGroup "reading-cats-api/internal/domain/group"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type CreateGroupUseCase struct {
@@ -27,7 +28,7 @@ func (uc *CreateGroupUseCase) Execute(ctx context.Context, in CreateGroupInput)
 	}
 
 	if user == nil {
-		return CreateGroupOutput{}, fmt.Errorf("user not found")
+		return CreateGroupOutput{}, ErrUserNotFound
 	}
 
 	// Validate input
@@ -41,35 +42,57 @@ func (uc *CreateGroupUseCase) Execute(ctx context.Context, in CreateGroupInput)
 		return CreateGroupOutput{}, err
 	}
 
+	visibility := domainGroup.VisibilityInviteOnly
+	if in.Visibility != "" {
+		visibility, err = domainGroup.NewVisibility(in.Visibility)
+		if err != nil {
+			return CreateGroupOutput{}, err
+		}
+	}
+
 	// Create group domain entity
 	groupID := uuid.NewString()
 	now := time.Now().UTC()
-	maxMembers := 5 // default
-	if in.MaxMembers != nil && *in.MaxMembers > 0 {
-		maxMembers = *in.MaxMembers
+	maxMembers := domainGroup.DefaultMaxMembers
+	if in.MaxMembers != nil {
+		maxMembers, err = domainGroup.NewMaxMembers(*in.MaxMembers)
+		if err != nil {
+			return CreateGroupOutput{}, err
+		}
 	}
 	g := domainGroup.New(
 		groupID,
 		name,
 		iconID,
-		domainGroup.VisibilityInviteOnly,
+		visibility,
 		maxMembers,
 		user.ID,
 		now,
 	)
 
-	// Insert group
-	if err := uc.repo.Insert(ctx, g); err != nil {
-		return CreateGroupOutput{}, fmt.Errorf("failed to insert group: %w", err)
-	}
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// Insert group
+		if err := uc.repo.Insert(ctx, tx, g); err != nil {
+			return fmt.Errorf("failed to insert group: %w", err)
+		}
 
-	// Add creator as ADMIN member
-	if err := uc.repo.AddMember(ctx, groupID, user.ID, "ADMIN"); err != nil {
-		return CreateGroupOutput{}, fmt.Errorf("failed to add user as group member: %w", err)
+		// Add creator as ADMIN member
+		if _, err := uc.repo.AddMember(ctx, tx, groupID, user.ID, domainGroup.RoleAdmin); err != nil {
+			return fmt.Errorf("failed to add user as group member: %w", err)
+		}
+
+		return recordEvent(ctx, uc.repo, tx, newEvent(groupID, domainGroup.EventGroupCreated, user.ID))
+	})
+	if err != nil {
+		return CreateGroupOutput{}, err
 	}
 
 	// Return DTO
-	return CreateGroupOutput{
+	return toGroupOutput(g), nil
+}
+
+func toGroupOutput(g *domainGroup.Group) CreateGroupOutput {
+	out := CreateGroupOutput{
 		ID:              g.ID,
 		Name:            string(g.Name),
 		IconID:          string(g.IconID),
@@ -78,5 +101,10 @@ func (uc *CreateGroupUseCase) Execute(ctx context.Context, in CreateGroupInput)
 		CreatedByUserID: g.CreatedByUserID,
 		CreatedAt:       g.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:       g.UpdatedAt.Format(time.RFC3339),
-	}, nil
+	}
+	if g.ArchivedAt != nil {
+		formatted := g.ArchivedAt.Format(time.RFC3339)
+		out.ArchivedAt = &formatted
+	}
+	return out
 }
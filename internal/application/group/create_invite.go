@@ -0,0 +1,77 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appUser "reading-cats-api/internal/application/user"
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/google/uuid"
+)
+
+// quantas vezes tentamos sortear outro código se bater num já existente
+const inviteCodeAttempts = 3
+
+type CreateInviteUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+	clock    func() time.Time
+}
+
+func NewCreateInviteUseCase(repo Repository, userRepo appUser.Repository, policy *Policy) *CreateInviteUseCase {
+	return &CreateInviteUseCase{repo: repo, userRepo: userRepo, policy: policy, clock: time.Now}
+}
+
+func (uc *CreateInviteUseCase) Execute(ctx context.Context, in CreateInviteInput) (InviteOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return InviteOutput{}, err
+	}
+	if user == nil {
+		return InviteOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanInvite(ctx, in.GroupID, user.ID); err != nil {
+		return InviteOutput{}, err
+	}
+
+	ttl := domainGroup.DefaultInviteTTL
+	if in.ExpiresInHours != nil {
+		ttl = time.Duration(*in.ExpiresInHours) * time.Hour
+	}
+
+	now := uc.clock().UTC()
+	for attempt := 0; attempt < inviteCodeAttempts; attempt++ {
+		code, err := domainGroup.GenerateInviteCode()
+		if err != nil {
+			return InviteOutput{}, fmt.Errorf("failed to generate invite code: %w", err)
+		}
+
+		inv, err := domainGroup.NewInvite(uuid.NewString(), in.GroupID, code, user.ID, ttl, in.SingleUse, now)
+		if err != nil {
+			return InviteOutput{}, err
+		}
+
+		err = uc.repo.InsertInvite(ctx, inv)
+		if errors.Is(err, ErrInviteCodeTaken) {
+			continue
+		}
+		if err != nil {
+			return InviteOutput{}, err
+		}
+
+		return InviteOutput{
+			Code:      string(inv.Code),
+			GroupID:   inv.GroupID,
+			ExpiresAt: inv.ExpiresAt.Format(time.RFC3339),
+			SingleUse: inv.SingleUse,
+			CreatedAt: inv.CreatedAt.Format(time.RFC3339),
+		}, nil
+	}
+
+	return InviteOutput{}, ErrInviteCodeTaken
+}
@@ -0,0 +1,50 @@
+package group
+
+import (
+	"context"
+
+	appUser "reading-cats-api/internal/application/user"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type DeleteGroupUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+}
+
+func NewDeleteGroupUseCase(repo Repository, userRepo appUser.Repository, policy *Policy) *DeleteGroupUseCase {
+	return &DeleteGroupUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+// Execute remove o grupo de vez (inclusive arquivado). Só o dono pode.
+func (uc *DeleteGroupUseCase) Execute(ctx context.Context, in DeleteGroupInput) error {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	// a posse é checada dentro da transação; aqui só garantimos que é membro
+	if _, err := uc.policy.CanView(ctx, in.GroupID, user.ID); err != nil {
+		return err
+	}
+
+	return uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		g, err := uc.repo.LockGroup(ctx, tx, in.GroupID)
+		if err != nil {
+			return err
+		}
+		if g == nil {
+			return ErrGroupNotFound
+		}
+		if !g.IsOwner(user.ID) {
+			return ErrForbidden
+		}
+
+		return uc.repo.Delete(ctx, tx, g.ID)
+	})
+}
@@ -0,0 +1,62 @@
+package group
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"reading-cats-api/internal/application/pagination"
+	appUser "reading-cats-api/internal/application/user"
+)
+
+type DiscoverGroupsUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+}
+
+func NewDiscoverGroupsUseCase(repo Repository, userRepo appUser.Repository) *DiscoverGroupsUseCase {
+	return &DiscoverGroupsUseCase{repo: repo, userRepo: userRepo}
+}
+
+func (uc *DiscoverGroupsUseCase) Execute(ctx context.Context, in DiscoverGroupsInput) (DiscoverGroupsOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return DiscoverGroupsOutput{}, err
+	}
+	if user == nil {
+		return DiscoverGroupsOutput{}, ErrUserNotFound
+	}
+
+	after, err := pagination.Decode(in.Cursor)
+	if err != nil {
+		return DiscoverGroupsOutput{}, err
+	}
+	limit := pagination.NormalizeLimit(in.Limit)
+
+	// busca 1 a mais pra saber se existe próxima página
+	rows, err := uc.repo.SearchPublic(ctx, strings.TrimSpace(in.Query), after, limit+1)
+	if err != nil {
+		return DiscoverGroupsOutput{}, err
+	}
+
+	out := DiscoverGroupsOutput{Groups: make([]PublicGroupOutput, 0, len(rows))}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		next := pagination.Cursor{At: last.LastActivityAt, ID: last.Group.ID}.Encode()
+		out.NextCursor = &next
+	}
+
+	for _, row := range rows {
+		out.Groups = append(out.Groups, PublicGroupOutput{
+			ID:             row.Group.ID,
+			Name:           string(row.Group.Name),
+			IconID:         string(row.Group.IconID),
+			MemberCount:    row.MemberCount,
+			MaxMembers:     row.Group.MaxMembers,
+			LastActivityAt: row.LastActivityAt.Format(time.RFC3339),
+		})
+	}
+
+	return out, nil
+}
@@ -1,6 +1,7 @@
 package group
 
 import (
+	domainGroup "reading-cats-api/internal/domain/group"
 	userDomain "reading-cats-api/internal/domain/user"
 )
 
@@ -9,15 +10,227 @@ type CreateGroupInput struct {
 	Name       string `json:"name"`
 	IconID     string `json:"icon_id"`
 	MaxMembers *int   `json:"max_members,omitempty"`
+	Visibility string `json:"visibility,omitempty"`
 }
 
 type CreateGroupOutput struct {
-	ID              string `json:"id"`
-	Name            string `json:"name"`
-	IconID          string `json:"icon_id"`
-	Visibility      string `json:"visibility"`
-	MaxMembers      int    `json:"max_members"`
-	CreatedByUserID string `json:"created_by_user_id"`
-	CreatedAt       string `json:"created_at"`
-	UpdatedAt       string `json:"updated_at"`
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	IconID          string  `json:"icon_id"`
+	Visibility      string  `json:"visibility"`
+	MaxMembers      int     `json:"max_members"`
+	CreatedByUserID string  `json:"created_by_user_id"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+	ArchivedAt      *string `json:"archived_at,omitempty"`
+}
+
+type ListMyGroupsInput struct {
+	Claims userDomain.IDPClaims
+	Cursor string
+	Limit  int
+}
+
+type ListMyGroupsOutput struct {
+	Groups     []MyGroupOutput `json:"groups"`
+	NextCursor *string         `json:"next_cursor,omitempty"`
+}
+
+type MyGroupOutput struct {
+	ID           string              `json:"id"`
+	Name         string              `json:"name"`
+	IconID       string              `json:"icon_id"`
+	Visibility   string              `json:"visibility"`
+	MyRole       string              `json:"my_role"`
+	MemberCount  int                 `json:"member_count"`
+	MaxMembers   int                 `json:"max_members"`
+	JoinedAt     string              `json:"joined_at"`
+	ArchivedAt   *string             `json:"archived_at,omitempty"`
+	ActiveSeason *ActiveSeasonOutput `json:"active_season"`
+}
+
+type ActiveSeasonOutput struct {
+	ID        string  `json:"id"`
+	Status    string  `json:"status"`
+	StartedAt *string `json:"started_at,omitempty"`
+	EndsAt    *string `json:"ends_at,omitempty"`
+	Timezone  string  `json:"timezone"`
+	Metric    string  `json:"metric"`
+}
+
+type GetGroupInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+}
+
+type GetGroupOutput struct {
+	Group   CreateGroupOutput   `json:"group"`
+	Today   string              `json:"today"`
+	Members []GroupMemberOutput `json:"members"`
+}
+
+type GroupMemberOutput struct {
+	UserID         string `json:"user_id"`
+	DisplayName    string `json:"display_name,omitempty"`
+	AvatarURL      string `json:"avatar_url,omitempty"`
+	Role           string `json:"role"`
+	JoinedAt       string `json:"joined_at"`
+	CheckedInToday bool   `json:"checked_in_today"`
+	PagesToday     int    `json:"pages_today"`
+}
+
+type CreateInviteInput struct {
+	Claims         userDomain.IDPClaims
+	GroupID        string
+	ExpiresInHours *int
+	SingleUse      bool
+}
+
+type InviteOutput struct {
+	Code      string `json:"code"`
+	GroupID   string `json:"group_id"`
+	ExpiresAt string `json:"expires_at"`
+	SingleUse bool   `json:"single_use"`
+	CreatedAt string `json:"created_at"`
+}
+
+type AcceptInviteInput struct {
+	Claims userDomain.IDPClaims
+	Code   domainGroup.InviteCode
+}
+
+type AcceptInviteOutput struct {
+	Group         CreateGroupOutput `json:"group"`
+	AlreadyMember bool              `json:"already_member"`
+}
+
+type LeaveGroupInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+}
+
+type RemoveMemberInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+	UserID  string
+}
+
+type ChangeMemberRoleInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+	UserID  string
+	Role    domainGroup.Role
+}
+
+type MemberRoleOutput struct {
+	GroupID string `json:"group_id"`
+	UserID  string `json:"user_id"`
+	Role    string `json:"role"`
+}
+
+type TransferOwnershipInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+	UserID  string
+}
+
+// UpdateGroupInput: campos nil ficam como estão.
+type UpdateGroupInput struct {
+	Claims     userDomain.IDPClaims
+	GroupID    string
+	Name       *string
+	IconID     *string
+	MaxMembers *int
+}
+
+type ArchiveGroupInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+}
+
+type DeleteGroupInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+}
+
+type DiscoverGroupsInput struct {
+	Claims userDomain.IDPClaims
+	Query  string
+	Cursor string
+	Limit  int
+}
+
+type DiscoverGroupsOutput struct {
+	Groups     []PublicGroupOutput `json:"groups"`
+	NextCursor *string             `json:"next_cursor,omitempty"`
+}
+
+type PublicGroupOutput struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	IconID         string `json:"icon_id"`
+	MemberCount    int    `json:"member_count"`
+	MaxMembers     int    `json:"max_members"`
+	LastActivityAt string `json:"last_activity_at"`
+}
+
+type CreateJoinRequestInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+}
+
+type ListJoinRequestsInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+}
+
+type ListJoinRequestsOutput struct {
+	Requests []JoinRequestOutput `json:"requests"`
+}
+
+// DecideJoinRequestInput: Approve=false recusa o pedido.
+type DecideJoinRequestInput struct {
+	Claims    userDomain.IDPClaims
+	GroupID   string
+	RequestID string
+	Approve   bool
+}
+
+type JoinRequestOutput struct {
+	ID              string  `json:"id"`
+	GroupID         string  `json:"group_id"`
+	UserID          string  `json:"user_id"`
+	DisplayName     string  `json:"display_name,omitempty"`
+	AvatarURL       string  `json:"avatar_url,omitempty"`
+	Status          string  `json:"status"`
+	CreatedAt       string  `json:"created_at"`
+	DecidedAt       *string `json:"decided_at,omitempty"`
+	DecidedByUserID *string `json:"decided_by_user_id,omitempty"`
+}
+
+type GetFeedInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+	Cursor  string
+	Limit   int
+}
+
+type GetFeedOutput struct {
+	Events     []FeedEventOutput `json:"events"`
+	NextCursor *string           `json:"next_cursor,omitempty"`
+}
+
+type FeedEventOutput struct {
+	ID        string           `json:"id"`
+	Type      string           `json:"type"`
+	Actor     *FeedActorOutput `json:"actor,omitempty"`
+	SeasonID  *string          `json:"season_id,omitempty"`
+	Data      map[string]any   `json:"data,omitempty"`
+	CreatedAt string           `json:"created_at"`
+}
+
+type FeedActorOutput struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	AvatarURL   string `json:"avatar_url,omitempty"`
 }
@@ -0,0 +1,19 @@
+package group
+
+import "errors"
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	// ErrGroupNotFound também cobre "não é membro": não queremos que IDs de grupo possam ser sondados.
+	ErrGroupNotFound   = errors.New("group not found")
+	ErrForbidden       = errors.New("forbidden")
+	ErrInviteNotFound  = errors.New("invite not found")
+	ErrInviteCodeTaken = errors.New("invite code already taken")
+	ErrMemberNotFound  = errors.New("member not found")
+	// pra sair do grupo o caminho é DELETE .../members/me
+	ErrCannotRemoveSelf = errors.New("cannot remove yourself, leave the group instead")
+
+	ErrAlreadyMember       = errors.New("already a member of this group")
+	ErrJoinRequestNotFound = errors.New("join request not found")
+	ErrJoinRequestExists   = errors.New("a join request for this group is already pending")
+)
@@ -0,0 +1,19 @@
+package group
+
+import (
+	"context"
+
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// recordEvent grava um item do feed na transação corrente, junto com a mudança que o originou.
+func recordEvent(ctx context.Context, repo Repository, tx pgx.Tx, e *domainGroup.Event) error {
+	return repo.InsertEvent(ctx, tx, e)
+}
+
+func newEvent(groupID string, eventType domainGroup.EventType, actorUserID string) *domainGroup.Event {
+	return domainGroup.NewEvent(uuid.NewString(), groupID, eventType, actorUserID)
+}
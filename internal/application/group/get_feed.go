@@ -0,0 +1,77 @@
+package group
+
+import (
+	"context"
+	"time"
+
+	"reading-cats-api/internal/application/pagination"
+	appUser "reading-cats-api/internal/application/user"
+)
+
+type GetFeedUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+}
+
+func NewGetFeedUseCase(repo Repository, userRepo appUser.Repository, policy *Policy) *GetFeedUseCase {
+	return &GetFeedUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+func (uc *GetFeedUseCase) Execute(ctx context.Context, in GetFeedInput) (GetFeedOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return GetFeedOutput{}, err
+	}
+	if user == nil {
+		return GetFeedOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanView(ctx, in.GroupID, user.ID); err != nil {
+		return GetFeedOutput{}, err
+	}
+
+	after, err := pagination.Decode(in.Cursor)
+	if err != nil {
+		return GetFeedOutput{}, err
+	}
+	limit := pagination.NormalizeLimit(in.Limit)
+
+	// busca 1 a mais pra saber se existe próxima página
+	rows, err := uc.repo.ListEvents(ctx, in.GroupID, after, limit+1)
+	if err != nil {
+		return GetFeedOutput{}, err
+	}
+
+	out := GetFeedOutput{Events: make([]FeedEventOutput, 0, len(rows))}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		next := pagination.Cursor{At: last.Event.CreatedAt, ID: last.Event.ID}.Encode()
+		out.NextCursor = &next
+	}
+
+	for _, row := range rows {
+		e := row.Event
+		item := FeedEventOutput{
+			ID:        e.ID,
+			Type:      e.Type.String(),
+			Data:      e.Data,
+			CreatedAt: e.CreatedAt.Format(time.RFC3339),
+		}
+		if e.ActorUserID != "" {
+			item.Actor = &FeedActorOutput{
+				UserID:      e.ActorUserID,
+				DisplayName: row.ActorDisplayName,
+				AvatarURL:   row.ActorAvatarURL,
+			}
+		}
+		if e.SeasonID != "" {
+			seasonID := e.SeasonID
+			item.SeasonID = &seasonID
+		}
+		out.Events = append(out.Events, item)
+	}
+
+	return out, nil
+}
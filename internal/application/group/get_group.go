@@ -0,0 +1,77 @@
+package group
+
+import (
+	"context"
+	"time"
+
+	appUser "reading-cats-api/internal/application/user"
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
+
+type GetGroupUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+	days     readingDomain.DayBoundary
+	clock    func() time.Time
+}
+
+// days é o fuso padrão da aplicação: "fez check-in hoje" segue o mesmo dia do registro de leitura.
+func NewGetGroupUseCase(repo Repository, userRepo appUser.Repository, policy *Policy, days readingDomain.DayBoundary) *GetGroupUseCase {
+	return &GetGroupUseCase{
+		repo:     repo,
+		userRepo: userRepo,
+		policy:   policy,
+		days:     days,
+		clock:    time.Now,
+	}
+}
+
+func (uc *GetGroupUseCase) Execute(ctx context.Context, in GetGroupInput) (GetGroupOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return GetGroupOutput{}, err
+	}
+	if user == nil {
+		return GetGroupOutput{}, ErrUserNotFound
+	}
+
+	// Só membros ativos enxergam o grupo; pro resto ele "não existe"
+	if _, err := uc.policy.CanView(ctx, in.GroupID, user.ID); err != nil {
+		return GetGroupOutput{}, err
+	}
+
+	g, err := uc.repo.FindByID(ctx, in.GroupID)
+	if err != nil {
+		return GetGroupOutput{}, err
+	}
+	if g == nil {
+		return GetGroupOutput{}, ErrGroupNotFound
+	}
+
+	today := uc.days.DateOf(uc.clock())
+
+	rows, err := uc.repo.ListActiveMembers(ctx, g.ID, today)
+	if err != nil {
+		return GetGroupOutput{}, err
+	}
+
+	members := make([]GroupMemberOutput, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, GroupMemberOutput{
+			UserID:         row.Member.UserID,
+			DisplayName:    row.DisplayName,
+			AvatarURL:      row.AvatarURL,
+			Role:           row.Member.Role.String(),
+			JoinedAt:       row.Member.JoinedAt.Format(time.RFC3339),
+			CheckedInToday: row.CheckedInToday,
+			PagesToday:     row.PagesToday,
+		})
+	}
+
+	return GetGroupOutput{
+		Group:   toGroupOutput(g),
+		Today:   today.String(),
+		Members: members,
+	}, nil
+}
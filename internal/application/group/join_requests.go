@@ -0,0 +1,193 @@
+package group
+
+import (
+	"context"
+	"time"
+
+	appUser "reading-cats-api/internal/application/user"
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type CreateJoinRequestUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	clock    func() time.Time
+}
+
+func NewCreateJoinRequestUseCase(repo Repository, userRepo appUser.Repository) *CreateJoinRequestUseCase {
+	return &CreateJoinRequestUseCase{repo: repo, userRepo: userRepo, clock: time.Now}
+}
+
+// Execute enfileira o pedido pra um admin decidir. Grupos que não são públicos
+// respondem como inexistentes, igual à Policy.
+func (uc *CreateJoinRequestUseCase) Execute(ctx context.Context, in CreateJoinRequestInput) (JoinRequestOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return JoinRequestOutput{}, err
+	}
+	if user == nil {
+		return JoinRequestOutput{}, ErrUserNotFound
+	}
+
+	g, err := uc.repo.FindByID(ctx, in.GroupID)
+	if err != nil {
+		return JoinRequestOutput{}, err
+	}
+	if g == nil || !g.Visibility.IsPublic() {
+		return JoinRequestOutput{}, ErrGroupNotFound
+	}
+	if err := g.EnsureNotArchived(); err != nil {
+		return JoinRequestOutput{}, err
+	}
+
+	member, err := uc.repo.GetMember(ctx, g.ID, user.ID)
+	if err != nil {
+		return JoinRequestOutput{}, err
+	}
+	if member != nil && member.IsActive {
+		return JoinRequestOutput{}, ErrAlreadyMember
+	}
+
+	req := domainGroup.NewJoinRequest(uuid.NewString(), g.ID, user.ID, uc.clock().UTC())
+	if err := uc.repo.InsertJoinRequest(ctx, req); err != nil {
+		return JoinRequestOutput{}, err
+	}
+
+	return toJoinRequestOutput(req), nil
+}
+
+type ListJoinRequestsUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+}
+
+func NewListJoinRequestsUseCase(repo Repository, userRepo appUser.Repository, policy *Policy) *ListJoinRequestsUseCase {
+	return &ListJoinRequestsUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+// Execute lista os pedidos pendentes, do mais antigo pro mais novo.
+func (uc *ListJoinRequestsUseCase) Execute(ctx context.Context, in ListJoinRequestsInput) (ListJoinRequestsOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return ListJoinRequestsOutput{}, err
+	}
+	if user == nil {
+		return ListJoinRequestsOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanManageMembers(ctx, in.GroupID, user.ID); err != nil {
+		return ListJoinRequestsOutput{}, err
+	}
+
+	rows, err := uc.repo.ListPendingJoinRequests(ctx, in.GroupID)
+	if err != nil {
+		return ListJoinRequestsOutput{}, err
+	}
+
+	out := ListJoinRequestsOutput{Requests: make([]JoinRequestOutput, 0, len(rows))}
+	for _, row := range rows {
+		r := toJoinRequestOutput(&row.Request)
+		r.DisplayName = row.DisplayName
+		r.AvatarURL = row.AvatarURL
+		out.Requests = append(out.Requests, r)
+	}
+	return out, nil
+}
+
+type DecideJoinRequestUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+	clock    func() time.Time
+}
+
+func NewDecideJoinRequestUseCase(repo Repository, userRepo appUser.Repository, policy *Policy) *DecideJoinRequestUseCase {
+	return &DecideJoinRequestUseCase{repo: repo, userRepo: userRepo, policy: policy, clock: time.Now}
+}
+
+// Execute aprova (entra como MEMBER, respeitando max_members) ou recusa o pedido.
+// Se o grupo estiver cheio a aprovação falha e o pedido continua pendente.
+func (uc *DecideJoinRequestUseCase) Execute(ctx context.Context, in DecideJoinRequestInput) (JoinRequestOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return JoinRequestOutput{}, err
+	}
+	if user == nil {
+		return JoinRequestOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanManageMembers(ctx, in.GroupID, user.ID); err != nil {
+		return JoinRequestOutput{}, err
+	}
+
+	var out JoinRequestOutput
+
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		g, err := uc.repo.LockGroup(ctx, tx, in.GroupID)
+		if err != nil {
+			return err
+		}
+		if g == nil {
+			return ErrGroupNotFound
+		}
+		// reject também é escrita de admin: grupo arquivado não decide pedidos
+		if err := g.EnsureNotArchived(); err != nil {
+			return err
+		}
+
+		req, err := uc.repo.LockJoinRequest(ctx, tx, in.RequestID)
+		if err != nil {
+			return err
+		}
+		if req == nil || req.GroupID != g.ID {
+			return ErrJoinRequestNotFound
+		}
+
+		now := uc.clock().UTC()
+		if in.Approve {
+			if err := req.Approve(user.ID, now); err != nil {
+				return err
+			}
+			// quem entrou por convite nesse meio tempo só tem o pedido marcado como aprovado
+			if _, err := joinGroup(ctx, uc.repo, tx, g, req.UserID, now); err != nil {
+				return err
+			}
+		} else {
+			if err := req.Reject(user.ID, now); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.repo.UpdateJoinRequest(ctx, tx, req); err != nil {
+			return err
+		}
+
+		out = toJoinRequestOutput(req)
+		return nil
+	})
+	if err != nil {
+		return JoinRequestOutput{}, err
+	}
+
+	return out, nil
+}
+
+func toJoinRequestOutput(r *domainGroup.JoinRequest) JoinRequestOutput {
+	out := JoinRequestOutput{
+		ID:              r.ID,
+		GroupID:         r.GroupID,
+		UserID:          r.UserID,
+		Status:          r.Status.String(),
+		CreatedAt:       r.CreatedAt.Format(time.RFC3339),
+		DecidedByUserID: r.DecidedByUserID,
+	}
+	if r.DecidedAt != nil {
+		formatted := r.DecidedAt.Format(time.RFC3339)
+		out.DecidedAt = &formatted
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package group
+
+import (
+	"context"
+	"errors"
+
+	appUser "reading-cats-api/internal/application/user"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type LeaveGroupUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+}
+
+func NewLeaveGroupUseCase(repo Repository, userRepo appUser.Repository) *LeaveGroupUseCase {
+	return &LeaveGroupUseCase{repo: repo, userRepo: userRepo}
+}
+
+func (uc *LeaveGroupUseCase) Execute(ctx context.Context, in LeaveGroupInput) error {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	return uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		g, err := uc.repo.LockGroup(ctx, tx, in.GroupID)
+		if err != nil {
+			return err
+		}
+		if g == nil {
+			return ErrGroupNotFound
+		}
+
+		err = leaveGroup(ctx, uc.repo, tx, g, user.ID, "")
+		if errors.Is(err, ErrMemberNotFound) {
+			// quem não é membro não deve nem saber que o grupo existe
+			return ErrGroupNotFound
+		}
+		return err
+	})
+}
@@ -0,0 +1,93 @@
+package group
+
+import (
+	"context"
+	"time"
+
+	"reading-cats-api/internal/application/pagination"
+	appUser "reading-cats-api/internal/application/user"
+	domainSeason "reading-cats-api/internal/domain/season"
+)
+
+type ListMyGroupsUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+}
+
+func NewListMyGroupsUseCase(repo Repository, userRepo appUser.Repository) *ListMyGroupsUseCase {
+	return &ListMyGroupsUseCase{repo: repo, userRepo: userRepo}
+}
+
+func (uc *ListMyGroupsUseCase) Execute(ctx context.Context, in ListMyGroupsInput) (ListMyGroupsOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return ListMyGroupsOutput{}, err
+	}
+	if user == nil {
+		return ListMyGroupsOutput{}, ErrUserNotFound
+	}
+
+	after, err := pagination.Decode(in.Cursor)
+	if err != nil {
+		return ListMyGroupsOutput{}, err
+	}
+	limit := pagination.NormalizeLimit(in.Limit)
+
+	// busca 1 a mais pra saber se existe próxima página
+	rows, err := uc.repo.ListByMember(ctx, user.ID, after, limit+1)
+	if err != nil {
+		return ListMyGroupsOutput{}, err
+	}
+
+	out := ListMyGroupsOutput{Groups: make([]MyGroupOutput, 0, len(rows))}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		next := pagination.Cursor{At: last.JoinedAt, ID: last.Group.ID}.Encode()
+		out.NextCursor = &next
+	}
+
+	for _, row := range rows {
+		var archivedAt *string
+		if row.Group.ArchivedAt != nil {
+			formatted := row.Group.ArchivedAt.Format(time.RFC3339)
+			archivedAt = &formatted
+		}
+		out.Groups = append(out.Groups, MyGroupOutput{
+			ID:           row.Group.ID,
+			Name:         string(row.Group.Name),
+			IconID:       string(row.Group.IconID),
+			Visibility:   row.Group.Visibility.String(),
+			MyRole:       row.Role.String(),
+			MemberCount:  row.MemberCount,
+			MaxMembers:   row.Group.MaxMembers,
+			JoinedAt:     row.JoinedAt.Format(time.RFC3339),
+			ArchivedAt:   archivedAt,
+			ActiveSeason: toActiveSeasonOutput(row.ActiveSeason),
+		})
+	}
+
+	return out, nil
+}
+
+func toActiveSeasonOutput(s *domainSeason.Season) *ActiveSeasonOutput {
+	if s == nil {
+		return nil
+	}
+
+	out := &ActiveSeasonOutput{
+		ID:       s.ID,
+		Status:   s.Status.String(),
+		Timezone: string(s.Timezone),
+		Metric:   s.Metric.String(),
+	}
+	if s.StartedAt != nil {
+		formatted := s.StartedAt.Format(time.RFC3339)
+		out.StartedAt = &formatted
+	}
+	if s.EndsAt != nil {
+		formatted := s.EndsAt.Format(time.RFC3339)
+		out.EndsAt = &formatted
+	}
+	return out
+}
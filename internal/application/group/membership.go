@@ -0,0 +1,100 @@
+package group
+
+import (
+	"context"
+	"time"
+
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// joinGroup adiciona o usuário como MEMBER respeitando max_members.
+// Deve rodar dentro de uma transação que já travou o grupo com LockGroup,
+// senão dois joins simultâneos podem estourar o limite. now vem do clock do use case.
+func joinGroup(ctx context.Context, repo Repository, tx pgx.Tx, g *domainGroup.Group, userID string, now time.Time) (bool, error) {
+	if err := g.EnsureNotArchived(); err != nil {
+		return false, err
+	}
+
+	count, err := repo.CountActiveMembers(ctx, tx, g.ID)
+	if err != nil {
+		return false, err
+	}
+	if err := g.EnsureCapacity(count); err != nil {
+		return false, err
+	}
+
+	joined, err := repo.AddMember(ctx, tx, g.ID, userID, domainGroup.RoleMember)
+	if err != nil || !joined {
+		return joined, err
+	}
+
+	if err := joinActiveSeason(ctx, repo, tx, g.ID, userID, now); err != nil {
+		return false, err
+	}
+
+	return true, recordEvent(ctx, repo, tx, newEvent(g.ID, domainGroup.EventMemberJoined, userID))
+}
+
+// joinActiveSeason aplica a política de entrada atrasada da season em andamento.
+// Quem já estava no roster (saiu e voltou) continua com a entrada original.
+func joinActiveSeason(ctx context.Context, repo Repository, tx pgx.Tx, groupID string, userID string, now time.Time) error {
+	s, err := repo.FindActiveSeasonByGroup(ctx, tx, groupID)
+	if err != nil || s == nil {
+		return err
+	}
+	p, ok := s.LateParticipant(userID, now.UTC())
+	if !ok {
+		return nil
+	}
+	return repo.AddSeasonParticipant(ctx, tx, p)
+}
+
+// leaveGroup desativa o membro mantendo o histórico (group_checkins continuam lá).
+// Se ele era o último ADMIN, o membro ativo mais antigo é promovido pra o grupo nunca ficar sem admin;
+// se era o dono, a posse passa pro ADMIN ativo mais antigo.
+// removedBy vem vazio quando o próprio membro saiu.
+func leaveGroup(ctx context.Context, repo Repository, tx pgx.Tx, g *domainGroup.Group, userID string, removedBy string) error {
+	role, found, err := repo.DeactivateMember(ctx, tx, g.ID, userID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrMemberNotFound
+	}
+
+	e := newEvent(g.ID, domainGroup.EventMemberLeft, userID)
+	if removedBy != "" {
+		e.With("removed_by_user_id", removedBy)
+	}
+	if err := recordEvent(ctx, repo, tx, e); err != nil {
+		return err
+	}
+
+	if !role.IsAdmin() {
+		return nil
+	}
+
+	admins, err := repo.CountActiveAdmins(ctx, tx, g.ID)
+	if err != nil {
+		return err
+	}
+	if admins == 0 {
+		if _, _, err := repo.PromoteOldestMember(ctx, tx, g.ID); err != nil {
+			return err
+		}
+	}
+
+	if !g.IsOwner(userID) {
+		return nil
+	}
+
+	// grupo vazio: o dono fica registrado até alguém entrar de novo
+	newOwner, found, err := repo.OldestActiveAdmin(ctx, tx, g.ID)
+	if err != nil || !found {
+		return err
+	}
+	_, err = repo.UpdateOwner(ctx, tx, g.ID, newOwner)
+	return err
+}
@@ -0,0 +1,99 @@
+package group
+
+import (
+	"context"
+
+	domainGroup "reading-cats-api/internal/domain/group"
+)
+
+// Action é uma operação sobre um grupo (ou suas seasons) sujeita a autorização.
+type Action string
+
+const (
+	ActionView          Action = "VIEW"
+	ActionInvite        Action = "INVITE"
+	ActionManageMembers Action = "MANAGE_MEMBERS"
+	ActionManageSeasons Action = "MANAGE_SEASONS"
+	ActionEditGroup     Action = "EDIT_GROUP"
+)
+
+// adminOnly lista as ações que exigem papel ADMIN; o resto basta ser membro ativo.
+// Todas elas alteram o grupo, então também são barradas em grupo arquivado.
+var adminOnly = map[Action]bool{
+	ActionInvite:        true,
+	ActionManageMembers: true,
+	ActionManageSeasons: true,
+	ActionEditGroup:     true,
+}
+
+// PolicyReader é o pedaço do repositório que a Policy precisa.
+type PolicyReader interface {
+	GetMember(ctx context.Context, groupID string, userID string) (*domainGroup.Member, error)
+	FindByID(ctx context.Context, groupID string) (*domainGroup.Group, error)
+}
+
+// Policy centraliza quem pode fazer o quê num grupo.
+// Quem não é membro ativo (ou o grupo nem existe) recebe ErrGroupNotFound, nunca ErrForbidden,
+// pra que IDs de grupo não possam ser sondados.
+type Policy struct {
+	reader PolicyReader
+}
+
+func NewPolicy(reader PolicyReader) *Policy {
+	return &Policy{reader: reader}
+}
+
+func (p *Policy) CanView(ctx context.Context, groupID string, userID string) (*domainGroup.Member, error) {
+	return p.authorize(ctx, groupID, userID, ActionView)
+}
+
+func (p *Policy) CanInvite(ctx context.Context, groupID string, userID string) (*domainGroup.Member, error) {
+	return p.authorize(ctx, groupID, userID, ActionInvite)
+}
+
+func (p *Policy) CanManageMembers(ctx context.Context, groupID string, userID string) (*domainGroup.Member, error) {
+	return p.authorize(ctx, groupID, userID, ActionManageMembers)
+}
+
+func (p *Policy) CanManageSeasons(ctx context.Context, groupID string, userID string) (*domainGroup.Member, error) {
+	return p.authorize(ctx, groupID, userID, ActionManageSeasons)
+}
+
+func (p *Policy) CanEditGroup(ctx context.Context, groupID string, userID string) (*domainGroup.Member, error) {
+	return p.authorize(ctx, groupID, userID, ActionEditGroup)
+}
+
+func (p *Policy) authorize(ctx context.Context, groupID string, userID string, action Action) (*domainGroup.Member, error) {
+	m, err := p.reader.GetMember(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := Authorize(m, action); err != nil {
+		return nil, err
+	}
+
+	if adminOnly[action] {
+		g, err := p.reader.FindByID(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		if g == nil {
+			return nil, ErrGroupNotFound
+		}
+		if err := g.EnsureNotArchived(); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Authorize é a regra pura, sem I/O: recebe a linha de group_members do usuário (ou nil).
+func Authorize(m *domainGroup.Member, action Action) error {
+	if m == nil || !m.IsActive {
+		return ErrGroupNotFound
+	}
+	if adminOnly[action] && !m.Role.IsAdmin() {
+		return ErrForbidden
+	}
+	return nil
+}
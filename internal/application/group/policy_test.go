@@ -0,0 +1,159 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainGroup "reading-cats-api/internal/domain/group"
+)
+
+var allActions = []Action{
+	ActionView,
+	ActionInvite,
+	ActionManageMembers,
+	ActionManageSeasons,
+	ActionEditGroup,
+}
+
+func TestAuthorize(t *testing.T) {
+	member := &domainGroup.Member{Role: domainGroup.RoleMember, IsActive: true}
+	admin := &domainGroup.Member{Role: domainGroup.RoleAdmin, IsActive: true}
+	inactiveAdmin := &domainGroup.Member{Role: domainGroup.RoleAdmin, IsActive: false}
+
+	tests := []struct {
+		name   string
+		member *domainGroup.Member
+		want   func(Action) error
+	}{
+		{"nil member", nil, func(Action) error { return ErrGroupNotFound }},
+		{"inactive member", inactiveAdmin, func(Action) error { return ErrGroupNotFound }},
+		{"member", member, func(a Action) error {
+			if a == ActionView {
+				return nil
+			}
+			return ErrForbidden
+		}},
+		{"admin", admin, func(Action) error { return nil }},
+	}
+
+	for _, tt := range tests {
+		for _, action := range allActions {
+			t.Run(tt.name+"/"+string(action), func(t *testing.T) {
+				err := Authorize(tt.member, action)
+				if want := tt.want(action); !errors.Is(err, want) {
+					t.Fatalf("Authorize(%s) = %v, want %v", action, err, want)
+				}
+			})
+		}
+	}
+}
+
+// fakePolicyReader serve um único grupo e os membros dele.
+type fakePolicyReader struct {
+	group   *domainGroup.Group
+	members map[string]*domainGroup.Member
+}
+
+func (f *fakePolicyReader) GetMember(_ context.Context, groupID string, userID string) (*domainGroup.Member, error) {
+	if f.group == nil || f.group.ID != groupID {
+		return nil, nil
+	}
+	return f.members[userID], nil
+}
+
+func (f *fakePolicyReader) FindByID(_ context.Context, groupID string) (*domainGroup.Group, error) {
+	if f.group == nil || f.group.ID != groupID {
+		return nil, nil
+	}
+	return f.group, nil
+}
+
+func newFakePolicyReader(archived bool) *fakePolicyReader {
+	g := &domainGroup.Group{ID: "g1"}
+	if archived {
+		at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		g.ArchivedAt = &at
+	}
+	return &fakePolicyReader{
+		group: g,
+		members: map[string]*domainGroup.Member{
+			"admin":  {GroupID: "g1", UserID: "admin", Role: domainGroup.RoleAdmin, IsActive: true},
+			"member": {GroupID: "g1", UserID: "member", Role: domainGroup.RoleMember, IsActive: true},
+		},
+	}
+}
+
+func canFor(p *Policy, action Action) func(ctx context.Context, groupID, userID string) (*domainGroup.Member, error) {
+	switch action {
+	case ActionInvite:
+		return p.CanInvite
+	case ActionManageMembers:
+		return p.CanManageMembers
+	case ActionManageSeasons:
+		return p.CanManageSeasons
+	case ActionEditGroup:
+		return p.CanEditGroup
+	}
+	return p.CanView
+}
+
+func TestPolicyArchivedGroup(t *testing.T) {
+	p := NewPolicy(newFakePolicyReader(true))
+
+	for _, action := range allActions {
+		t.Run(string(action), func(t *testing.T) {
+			_, err := canFor(p, action)(context.Background(), "g1", "admin")
+			if action == ActionView {
+				if err != nil {
+					t.Fatalf("view on archived group: got %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, domainGroup.ErrGroupArchived) {
+				t.Fatalf("%s on archived group: got %v, want ErrGroupArchived", action, err)
+			}
+		})
+	}
+}
+
+func TestPolicyGroupNotFound(t *testing.T) {
+	p := NewPolicy(newFakePolicyReader(false))
+
+	for _, action := range allActions {
+		t.Run(string(action), func(t *testing.T) {
+			_, err := canFor(p, action)(context.Background(), "missing", "admin")
+			if !errors.Is(err, ErrGroupNotFound) {
+				t.Fatalf("%s on missing group: got %v, want ErrGroupNotFound", action, err)
+			}
+		})
+	}
+}
+
+func TestPolicyRoles(t *testing.T) {
+	p := NewPolicy(newFakePolicyReader(false))
+
+	for _, action := range allActions {
+		t.Run(string(action), func(t *testing.T) {
+			m, err := canFor(p, action)(context.Background(), "g1", "admin")
+			if err != nil || m == nil || m.UserID != "admin" {
+				t.Fatalf("admin %s: got (%v, %v), want the admin member", action, m, err)
+			}
+
+			_, err = canFor(p, action)(context.Background(), "g1", "member")
+			if action == ActionView {
+				if err != nil {
+					t.Fatalf("member view: got %v, want nil", err)
+				}
+			} else if !errors.Is(err, ErrForbidden) {
+				t.Fatalf("member %s: got %v, want ErrForbidden", action, err)
+			}
+
+			_, err = canFor(p, action)(context.Background(), "g1", "stranger")
+			if !errors.Is(err, ErrGroupNotFound) {
+				t.Fatalf("non-member %s: got %v, want ErrGroupNotFound", action, err)
+			}
+		})
+	}
+}
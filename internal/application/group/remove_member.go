@@ -0,0 +1,54 @@
+package group
+
+import (
+	"context"
+
+	appUser "reading-cats-api/internal/application/user"
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type RemoveMemberUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+}
+
+func NewRemoveMemberUseCase(repo Repository, userRepo appUser.Repository, policy *Policy) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+func (uc *RemoveMemberUseCase) Execute(ctx context.Context, in RemoveMemberInput) error {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanManageMembers(ctx, in.GroupID, user.ID); err != nil {
+		return err
+	}
+	if in.UserID == user.ID {
+		return ErrCannotRemoveSelf
+	}
+
+	return uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		g, err := uc.repo.LockGroup(ctx, tx, in.GroupID)
+		if err != nil {
+			return err
+		}
+		if g == nil {
+			return ErrGroupNotFound
+		}
+		if err := g.EnsureNotArchived(); err != nil {
+			return err
+		}
+		if g.IsOwner(in.UserID) {
+			return domainGroup.ErrCannotRemoveOwner
+		}
+		return leaveGroup(ctx, uc.repo, tx, g, in.UserID, user.ID)
+	})
+}
@@ -2,10 +2,129 @@ package group
 
 import (
 	"context"
+	"time"
+
+	"reading-cats-api/internal/application/pagination"
 	domainGroup "reading-cats-api/internal/domain/group"
+	readingDomain "reading-cats-api/internal/domain/reading"
+	domainSeason "reading-cats-api/internal/domain/season"
+
+	"github.com/jackc/pgx/v5"
 )
 
+// MemberGroupRow é um grupo visto pela ótica de um membro ativo.
+type MemberGroupRow struct {
+	Group        domainGroup.Group
+	Role         domainGroup.Role
+	JoinedAt     time.Time
+	MemberCount  int
+	ActiveSeason *domainSeason.Season
+}
+
+// MemberRow é um membro ativo com os dados de perfil e o check-in do dia consultado.
+type MemberRow struct {
+	Member         domainGroup.Member
+	DisplayName    string
+	AvatarURL      string
+	CheckedInToday bool
+	PagesToday     int
+}
+
+// PublicGroupRow é um grupo público como aparece na busca.
+type PublicGroupRow struct {
+	Group          domainGroup.Group
+	MemberCount    int
+	LastActivityAt time.Time
+}
+
+// JoinRequestRow é um pedido de entrada com o perfil de quem pediu.
+type JoinRequestRow struct {
+	Request     domainGroup.JoinRequest
+	DisplayName string
+	AvatarURL   string
+}
+
+// EventRow é um evento do feed com o perfil de quem o gerou (vazio se não houver ator).
+type EventRow struct {
+	Event            domainGroup.Event
+	ActorDisplayName string
+	ActorAvatarURL   string
+}
+
 type Repository interface {
-	Insert(ctx context.Context, g *domainGroup.Group) error
-	AddMember(ctx context.Context, groupID string, userID string, role string) error
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
+
+	// reads
+	ListByMember(ctx context.Context, userID string, after *pagination.Cursor, limit int) ([]MemberGroupRow, error)
+	FindByID(ctx context.Context, groupID string) (*domainGroup.Group, error)
+	GetMember(ctx context.Context, groupID string, userID string) (*domainGroup.Member, error)
+	ListActiveMembers(ctx context.Context, groupID string, date readingDomain.LocalDate) ([]MemberRow, error)
+	// SearchPublic busca grupos PUBLIC_SOON não arquivados por prefixo ou similaridade de nome,
+	// do mais ativo pro menos ativo. query vazia lista todos.
+	SearchPublic(ctx context.Context, query string, after *pagination.Cursor, limit int) ([]PublicGroupRow, error)
+	ListPendingJoinRequests(ctx context.Context, groupID string) ([]JoinRequestRow, error)
+	// ListEvents pagina o feed do grupo do mais novo pro mais antigo.
+	ListEvents(ctx context.Context, groupID string, after *pagination.Cursor, limit int) ([]EventRow, error)
+
+	// tx: leituras com lock
+	LockGroup(ctx context.Context, tx pgx.Tx, groupID string) (*domainGroup.Group, error)
+	LockInviteByCode(ctx context.Context, tx pgx.Tx, code domainGroup.InviteCode) (*domainGroup.Invite, error)
+	LockJoinRequest(ctx context.Context, tx pgx.Tx, requestID string) (*domainGroup.JoinRequest, error)
+	CountActiveMembers(ctx context.Context, tx pgx.Tx, groupID string) (int, error)
+	CountActiveAdmins(ctx context.Context, tx pgx.Tx, groupID string) (int, error)
+	// ListActiveSeasonsByUser devolve as seasons ACTIVE dos grupos (não arquivados) em que o usuário é
+	// membro ativo e está no roster da season.
+	ListActiveSeasonsByUser(ctx context.Context, tx pgx.Tx, userID string) ([]domainSeason.Season, error)
+	// FindActiveSeasonByGroup retorna nil quando o grupo não tem season ACTIVE.
+	FindActiveSeasonByGroup(ctx context.Context, tx pgx.Tx, groupID string) (*domainSeason.Season, error)
+	// AddSeasonParticipant põe o membro no roster; não mexe em quem já está.
+	AddSeasonParticipant(ctx context.Context, tx pgx.Tx, p domainSeason.Participant) error
+	// ListSeasonsByUserCheckin devolve as seasons (de qualquer status) em que o dia pessoal entrou,
+	// inclusive as que deixaram de contá-lo depois de uma correção.
+	ListSeasonsByUserCheckin(ctx context.Context, tx pgx.Tx, userCheckinID string) ([]domainSeason.Season, error)
+	// ListActiveGroupIDsByUser devolve os grupos não arquivados em que o usuário é membro ativo.
+	ListActiveGroupIDsByUser(ctx context.Context, tx pgx.Tx, userID string) ([]string, error)
+
+	// writes
+	Insert(ctx context.Context, tx pgx.Tx, g *domainGroup.Group) error
+	// AddMember insere o membro ou reativa a linha existente de quem já saiu.
+	// Retorna false quando o usuário já era membro ativo.
+	AddMember(ctx context.Context, tx pgx.Tx, groupID string, userID string, role domainGroup.Role) (bool, error)
+	// DeactivateMember marca left_at/is_active e devolve o papel que o membro tinha.
+	// found=false quando não havia membro ativo com esse user_id.
+	DeactivateMember(ctx context.Context, tx pgx.Tx, groupID string, userID string) (role domainGroup.Role, found bool, err error)
+	// PromoteOldestMember promove a ADMIN o membro ativo mais antigo; found=false se o grupo ficou vazio.
+	PromoteOldestMember(ctx context.Context, tx pgx.Tx, groupID string) (userID string, found bool, err error)
+	// OldestActiveAdmin devolve o ADMIN ativo mais antigo (candidato natural a dono).
+	OldestActiveAdmin(ctx context.Context, tx pgx.Tx, groupID string) (userID string, found bool, err error)
+	UpdateMemberRole(ctx context.Context, tx pgx.Tx, groupID string, userID string, role domainGroup.Role) error
+	// UpdateSettings grava nome, ícone e max_members e devolve o updated_at gerado pelo trigger.
+	UpdateSettings(ctx context.Context, tx pgx.Tx, g *domainGroup.Group) (time.Time, error)
+	// UpdateOwner troca groups.created_by_user_id e devolve o updated_at gerado pelo trigger.
+	UpdateOwner(ctx context.Context, tx pgx.Tx, groupID string, userID string) (time.Time, error)
+	// Archive grava archived_at e devolve o updated_at gerado pelo trigger.
+	Archive(ctx context.Context, tx pgx.Tx, groupID string, at time.Time) (time.Time, error)
+	// EndActiveSeason encerra a season ACTIVE do grupo, se houver; ended=false quando não havia nenhuma.
+	EndActiveSeason(ctx context.Context, tx pgx.Tx, groupID string, at time.Time) (seasonID string, ended bool, err error)
+	// Delete apaga o grupo; membros, seasons, check-ins e convites vão junto via ON DELETE CASCADE.
+	Delete(ctx context.Context, tx pgx.Tx, groupID string) error
+	// InsertCheckin grava (ou volta a contar) o check-in na season; inserted=false se o dia
+	// pessoal já estava contado.
+	InsertCheckin(ctx context.Context, tx pgx.Tx, c domainSeason.Checkin) (inserted bool, err error)
+	// SetCheckinQualified marca se o dia pessoal conta na season, sem tirar a linha: uma correção
+	// posterior ainda encontra a season por ListSeasonsByUserCheckin.
+	SetCheckinQualified(ctx context.Context, tx pgx.Tx, seasonID string, userCheckinID string, qualified bool) error
+	// InsertEvent grava o evento com created_at = now() da transação e preenche e.CreatedAt.
+	InsertEvent(ctx context.Context, tx pgx.Tx, e *domainGroup.Event) error
+	InsertInvite(ctx context.Context, inv *domainGroup.Invite) error
+	IncrementInviteUses(ctx context.Context, tx pgx.Tx, inviteID string) error
+	// InsertJoinRequest devolve ErrJoinRequestExists se já houver pedido pendente do usuário.
+	InsertJoinRequest(ctx context.Context, r *domainGroup.JoinRequest) error
+	UpdateJoinRequest(ctx context.Context, tx pgx.Tx, r *domainGroup.JoinRequest) error
+}
+
+// SeasonFinalizer congela os resultados da season que o arquivamento encerrou, na mesma transação
+// (application/season implementa; o grupo não conhece ranking).
+type SeasonFinalizer interface {
+	FinalizeSeason(ctx context.Context, tx pgx.Tx, seasonID string) error
 }
@@ -0,0 +1,84 @@
+package group
+
+import (
+	"context"
+
+	appUser "reading-cats-api/internal/application/user"
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type TransferOwnershipUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+}
+
+func NewTransferOwnershipUseCase(repo Repository, userRepo appUser.Repository, policy *Policy) *TransferOwnershipUseCase {
+	return &TransferOwnershipUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+func (uc *TransferOwnershipUseCase) Execute(ctx context.Context, in TransferOwnershipInput) (CreateGroupOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return CreateGroupOutput{}, err
+	}
+	if user == nil {
+		return CreateGroupOutput{}, ErrUserNotFound
+	}
+
+	// a posse é checada dentro da transação; aqui só garantimos que é membro
+	if _, err := uc.policy.CanView(ctx, in.GroupID, user.ID); err != nil {
+		return CreateGroupOutput{}, err
+	}
+
+	var out CreateGroupOutput
+
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		g, err := uc.repo.LockGroup(ctx, tx, in.GroupID)
+		if err != nil {
+			return err
+		}
+		if g == nil {
+			return ErrGroupNotFound
+		}
+		// só o dono atual passa a posse adiante
+		if !g.IsOwner(user.ID) {
+			return ErrForbidden
+		}
+		if in.UserID == user.ID {
+			out = toGroupOutput(g)
+			return nil
+		}
+
+		target, err := uc.repo.GetMember(ctx, g.ID, in.UserID)
+		if err != nil {
+			return err
+		}
+		if target == nil || !target.IsActive {
+			return ErrMemberNotFound
+		}
+
+		if err := target.ChangeRole(domainGroup.RoleAdmin, g, 0); err != nil {
+			return err
+		}
+		if err := uc.repo.UpdateMemberRole(ctx, tx, g.ID, target.UserID, target.Role); err != nil {
+			return err
+		}
+
+		updatedAt, err := uc.repo.UpdateOwner(ctx, tx, g.ID, target.UserID)
+		if err != nil {
+			return err
+		}
+		g.TransferOwnership(target.UserID, updatedAt)
+
+		out = toGroupOutput(g)
+		return nil
+	})
+	if err != nil {
+		return CreateGroupOutput{}, err
+	}
+
+	return out, nil
+}
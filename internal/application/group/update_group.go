@@ -0,0 +1,109 @@
+package group
+
+import (
+	"context"
+
+	appUser "reading-cats-api/internal/application/user"
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type UpdateGroupUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *Policy
+}
+
+func NewUpdateGroupUseCase(repo Repository, userRepo appUser.Repository, policy *Policy) *UpdateGroupUseCase {
+	return &UpdateGroupUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+func (uc *UpdateGroupUseCase) Execute(ctx context.Context, in UpdateGroupInput) (CreateGroupOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return CreateGroupOutput{}, err
+	}
+	if user == nil {
+		return CreateGroupOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanEditGroup(ctx, in.GroupID, user.ID); err != nil {
+		return CreateGroupOutput{}, err
+	}
+
+	// Validate input antes de abrir a transação
+	var (
+		name       *domainGroup.GroupName
+		iconID     *domainGroup.IconID
+		maxMembers *int
+	)
+	if in.Name != nil {
+		v, err := domainGroup.NewGroupName(*in.Name)
+		if err != nil {
+			return CreateGroupOutput{}, err
+		}
+		name = &v
+	}
+	if in.IconID != nil {
+		v, err := domainGroup.NewIconID(*in.IconID)
+		if err != nil {
+			return CreateGroupOutput{}, err
+		}
+		iconID = &v
+	}
+	if in.MaxMembers != nil {
+		v, err := domainGroup.NewMaxMembers(*in.MaxMembers)
+		if err != nil {
+			return CreateGroupOutput{}, err
+		}
+		maxMembers = &v
+	}
+
+	var out CreateGroupOutput
+
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// o lock serializa com joins concorrentes, então a contagem abaixo é confiável
+		g, err := uc.repo.LockGroup(ctx, tx, in.GroupID)
+		if err != nil {
+			return err
+		}
+		if g == nil {
+			return ErrGroupNotFound
+		}
+		// a Policy checou o arquivamento fora da transação; com o grupo travado, checa de novo
+		if err := g.EnsureNotArchived(); err != nil {
+			return err
+		}
+
+		if name != nil {
+			g.Name = *name
+		}
+		if iconID != nil {
+			g.IconID = *iconID
+		}
+		if maxMembers != nil {
+			active, err := uc.repo.CountActiveMembers(ctx, tx, g.ID)
+			if err != nil {
+				return err
+			}
+			if err := g.ChangeMaxMembers(*maxMembers, active); err != nil {
+				return err
+			}
+		}
+
+		updatedAt, err := uc.repo.UpdateSettings(ctx, tx, g)
+		if err != nil {
+			return err
+		}
+		g.UpdatedAt = updatedAt
+
+		out = toGroupOutput(g)
+		return nil
+	})
+	if err != nil {
+		return CreateGroupOutput{}, err
+	}
+
+	return out, nil
+}
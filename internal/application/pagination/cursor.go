@@ -0,0 +1,63 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 50
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor marca a posição do último item de uma página ordenada por (At DESC, ID DESC).
+// Para o cliente ele é opaco: só devolvemos/recebemos a string codificada.
+type Cursor struct {
+	At time.Time
+	ID string
+}
+
+func (c Cursor) Encode() string {
+	raw := c.At.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode retorna nil quando o cursor vem vazio (primeira página).
+func Decode(s string) (*Cursor, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	at, id, ok := strings.Cut(string(b), "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{At: t, ID: id}, nil
+}
+
+// NormalizeLimit aplica o default quando o cliente não manda limit e corta no máximo.
+func NormalizeLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
@@ -13,14 +13,14 @@ import (
 type ChangeGoalUseCase struct {
 	repo     Repository
 	userRepo appUser.Repository
-	timezone string
+	days     readingDomain.DayBoundary
 }
 
-func NewChangeGoalUseCase(repo Repository, userRepo appUser.Repository, timezone string) *ChangeGoalUseCase {
+func NewChangeGoalUseCase(repo Repository, userRepo appUser.Repository, days readingDomain.DayBoundary) *ChangeGoalUseCase {
 	return &ChangeGoalUseCase{
 		repo:     repo,
 		userRepo: userRepo,
-		timezone: timezone,
+		days:     days,
 	}
 }
 
@@ -36,57 +36,44 @@ func (uc *ChangeGoalUseCase) Execute(ctx context.Context, in ChangeGoalInput) (C
 
 	userID := user.ID
 
-	loc, err := time.LoadLocation(uc.timezone)
-	if err != nil {
-		loc = time.UTC
-	}
-
-	now := time.Now().In(loc)
-	today := readingDomain.DateOf(now, loc)
+	today := uc.days.DateOf(time.Now())
 	tomorrow := today.AddDays(1)
 
 	var currentGoal *GoalRecord
 	var nextGoal *GoalRecord
 
 	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		currentPages, hasCurrentGoal, err := uc.repo.GetCurrentGoal(ctx, tx, userID)
+		current, hasCurrentGoal, err := uc.repo.GetCurrentGoal(ctx, tx, userID)
 		if err != nil {
 			return err
 		}
 
 		if !hasCurrentGoal {
-			if err := uc.repo.InsertGoal(ctx, tx, userID, 5, today); err != nil {
+			current = readingDomain.DefaultDailyGoal
+			if err := uc.repo.InsertGoal(ctx, tx, userID, current, today); err != nil {
 				return err
 			}
-			currentPages = 5
 		}
 
-		currentGoal = &GoalRecord{
-			DailyPages: currentPages,
-			ValidFrom:  today.String(),
-		}
+		currentGoal = newGoalRecord(current, today)
 
-		nextPages, hasNextGoal, err := uc.repo.GetNextGoal(ctx, tx, userID, tomorrow)
+		next, hasNextGoal, err := uc.repo.GetNextGoal(ctx, tx, userID, tomorrow)
 		if err != nil {
 			return err
 		}
 
+		// a meta nova substitui a anterior inteira: unidade não informada fica sem meta
 		if !hasNextGoal {
-			if err := uc.repo.InsertGoal(ctx, tx, userID, int(in.Pages), tomorrow); err != nil {
+			if err := uc.repo.InsertGoal(ctx, tx, userID, in.Goal, tomorrow); err != nil {
 				return err
 			}
-			nextPages = int(in.Pages)
-		} else if nextPages != int(in.Pages) {
-			if err := uc.repo.UpdateGoalPages(ctx, tx, userID, int(in.Pages), tomorrow); err != nil {
+		} else if next != in.Goal {
+			if err := uc.repo.UpdateGoal(ctx, tx, userID, in.Goal, tomorrow); err != nil {
 				return err
 			}
-			nextPages = int(in.Pages)
 		}
 
-		nextGoal = &GoalRecord{
-			DailyPages: nextPages,
-			ValidFrom:  tomorrow.String(),
-		}
+		nextGoal = newGoalRecord(in.Goal, tomorrow)
 
 		return nil
 	})
@@ -7,7 +7,7 @@ import (
 
 type ChangeGoalInput struct {
 	Claims userDomain.IDPClaims
-	Pages  readingDomain.Pages
+	Goal   readingDomain.DailyGoal
 }
 
 type ChangeGoalOutput struct {
@@ -16,6 +16,15 @@ type ChangeGoalOutput struct {
 }
 
 type GoalRecord struct {
-	DailyPages int    `json:"daily_pages"`
-	ValidFrom  string `json:"valid_from"`
+	DailyPages   int    `json:"daily_pages"`
+	DailyMinutes int    `json:"daily_minutes"`
+	ValidFrom    string `json:"valid_from"`
+}
+
+func newGoalRecord(g readingDomain.DailyGoal, validFrom readingDomain.LocalDate) *GoalRecord {
+	return &GoalRecord{
+		DailyPages:   g.Pages,
+		DailyMinutes: g.Minutes,
+		ValidFrom:    validFrom.String(),
+	}
 }
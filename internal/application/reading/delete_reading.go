@@ -0,0 +1,64 @@
+package reading
+
+import (
+	"context"
+	"time"
+
+	appUser "reading-cats-api/internal/application/user"
+	readingDomain "reading-cats-api/internal/domain/reading"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// DeleteReadingUseCase apaga um dia registrado por engano, dentro da janela de correção.
+// Os group_checkins do dia caem junto; os eventos do feed ficam como histórico.
+type DeleteReadingUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	guard    CheckinEditGuard
+	clock    func() time.Time
+}
+
+func NewDeleteReadingUseCase(repo Repository, userRepo appUser.Repository, guard CheckinEditGuard) *DeleteReadingUseCase {
+	return &DeleteReadingUseCase{
+		repo:     repo,
+		userRepo: userRepo,
+		guard:    guard,
+		clock:    time.Now,
+	}
+}
+
+func (uc *DeleteReadingUseCase) Execute(ctx context.Context, in DeleteReadingInput) error {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	return uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		day, found, err := uc.repo.GetDay(ctx, tx, user.ID, in.Date)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return ErrReadingDayNotFound
+		}
+
+		if err := ensureEditable(ctx, tx, uc.guard, day, uc.clock()); err != nil {
+			return err
+		}
+
+		// o streak do dia seguinte foi calculado em cima deste: apagar quebraria a sequência gravada
+		hasNext, err := uc.repo.ExistsDay(ctx, tx, user.ID, in.Date.AddDays(1))
+		if err != nil {
+			return err
+		}
+		if hasNext {
+			return readingDomain.ErrCheckinLocked
+		}
+
+		return uc.repo.DeleteDay(ctx, tx, user.ID, in.Date)
+	})
+}
@@ -5,9 +5,12 @@ import (
 	userDomain "reading-cats-api/internal/domain/user"
 )
 
+// RegisterReadingInput: Pages e Minutes podem vir sozinhos ou juntos (0 = não informado).
 type RegisterReadingInput struct {
-	Claims userDomain.IDPClaims
-	Pages  readingDomain.Pages
+	Claims  userDomain.IDPClaims
+	Pages   readingDomain.Pages
+	Minutes readingDomain.Minutes
+	Source  readingDomain.Source
 }
 
 type RegisterReadingOutput struct {
@@ -23,3 +26,44 @@ type GetReadingProgressOutput struct {
 	CurrentGoal *GoalRecord                   `json:"current_goal"`
 	NextGoal    *GoalRecord                   `json:"next_goal,omitempty"`
 }
+
+// EditReadingInput corrige os totais de um dia já registrado (substitui, não soma).
+type EditReadingInput struct {
+	Claims  userDomain.IDPClaims
+	Date    readingDomain.LocalDate
+	Pages   readingDomain.Pages
+	Minutes readingDomain.Minutes
+}
+
+type DeleteReadingInput struct {
+	Claims userDomain.IDPClaims
+	Date   readingDomain.LocalDate
+}
+
+type ReadingDayOutput struct {
+	Date       string `json:"date"`
+	Pages      int    `json:"pages"`
+	Minutes    int    `json:"minutes"`
+	StreakDays int    `json:"streak_days"`
+}
+
+// ListReadingLogsInput: Date vazio = o dia em que um registro agora cairia.
+type ListReadingLogsInput struct {
+	Claims userDomain.IDPClaims
+	Date   readingDomain.LocalDate
+}
+
+type ListReadingLogsOutput struct {
+	Date         string             `json:"date"`
+	PagesTotal   int                `json:"pages_total"`
+	MinutesTotal int                `json:"minutes_total"`
+	Entries      []ReadingLogOutput `json:"entries"`
+}
+
+type ReadingLogOutput struct {
+	ID       string `json:"id"`
+	Pages    int    `json:"pages"`
+	Minutes  int    `json:"minutes"`
+	Source   string `json:"source"`
+	LoggedAt string `json:"logged_at"`
+}
@@ -0,0 +1,126 @@
+package reading
+
+import (
+	"context"
+	"time"
+
+	appUser "reading-cats-api/internal/application/user"
+	readingDomain "reading-cats-api/internal/domain/reading"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// EditReadingUseCase corrige os totais de um dia dentro da janela de correção.
+// group_checkins apontam pro dia pessoal, então rankings e leaderboards já leem o valor novo;
+// só a qualificação do dia em cada season é reavaliada.
+type EditReadingUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	guard    CheckinEditGuard
+	clock    func() time.Time
+}
+
+func NewEditReadingUseCase(repo Repository, userRepo appUser.Repository, guard CheckinEditGuard) *EditReadingUseCase {
+	return &EditReadingUseCase{
+		repo:     repo,
+		userRepo: userRepo,
+		guard:    guard,
+		clock:    time.Now,
+	}
+}
+
+func (uc *EditReadingUseCase) Execute(ctx context.Context, in EditReadingInput) (ReadingDayOutput, error) {
+	if in.Pages == 0 && in.Minutes == 0 {
+		// zerar o dia é apagar: DELETE /v1/reading/logs/{date}
+		return ReadingDayOutput{}, readingDomain.ErrEmptyReading
+	}
+
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return ReadingDayOutput{}, err
+	}
+	if user == nil {
+		return ReadingDayOutput{}, ErrUserNotFound
+	}
+
+	var out ReadingDayOutput
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		day, found, err := uc.repo.GetDay(ctx, tx, user.ID, in.Date)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return ErrReadingDayNotFound
+		}
+
+		if err := ensureEditable(ctx, tx, uc.guard, day, uc.clock()); err != nil {
+			return err
+		}
+
+		prev := day
+		day, err = uc.repo.SetDayTotals(ctx, tx, user.ID, in.Date, int(in.Pages), int(in.Minutes))
+		if err != nil {
+			return err
+		}
+
+		// o ajuste entra no histórico como diferença, pra soma dos registros continuar batendo
+		if day.Pages != prev.Pages || day.Minutes != prev.Minutes {
+			err = uc.repo.InsertLog(ctx, tx, user.ID, &readingDomain.LogEntry{
+				UserCheckinID: day.ID,
+				Date:          day.Date,
+				Pages:         day.Pages - prev.Pages,
+				Minutes:       day.Minutes - prev.Minutes,
+				Source:        readingDomain.SourceCorrection,
+				LoggedAt:      uc.clock(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if uc.guard != nil {
+			// a meta que vale é a do dia corrigido, não a de hoje
+			goal, hasGoal, err := uc.repo.GetGoalOn(ctx, tx, user.ID, day.Date)
+			if err != nil {
+				return err
+			}
+			if !hasGoal {
+				goal = readingDomain.DefaultDailyGoal
+			}
+
+			// At = último registro: só decide a entrada em season ativa que ainda não tinha o dia
+			err = uc.guard.AfterEdit(ctx, tx, readingDomain.Checkin{
+				UserID:        user.ID,
+				UserCheckinID: day.ID,
+				Date:          day.Date,
+				FirstLoggedAt: day.FirstLoggedAt,
+				At:            day.LoggedAt,
+				Pages:         day.Pages,
+				Minutes:       day.Minutes,
+				StreakDays:    readingDomain.StreakDays(day.StreakDays),
+				Goal:          goal,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		out = ReadingDayOutput{
+			Date:       day.Date.String(),
+			Pages:      day.Pages,
+			Minutes:    day.Minutes,
+			StreakDays: day.StreakDays,
+		}
+		return nil
+	})
+
+	return out, err
+}
+
+// ensureEditable consulta o guard (regra das seasons); sem guard vale a janela padrão.
+func ensureEditable(ctx context.Context, tx pgx.Tx, guard CheckinEditGuard, day DayRow, now time.Time) error {
+	if guard == nil {
+		return readingDomain.EnsureEditable(day.LoggedAt, now, readingDomain.DefaultEditWindow)
+	}
+	return guard.EnsureEditable(ctx, tx, day.ID, day.LoggedAt, now)
+}
@@ -11,22 +11,20 @@ import (
 )
 
 type GetReadingProgressUseCase struct {
-	repo        Repository
-	userRepo    appUser.Repository
-	defaultTZ   string
-	graceHour   int
-	goalDefault int
-	clock       func() time.Time
+	repo      Repository
+	userRepo  appUser.Repository
+	days      readingDomain.DayBoundary
+	graceHour int
+	clock     func() time.Time
 }
 
-func NewGetReadingProgressUseCase(repo Repository, userRepo appUser.Repository, defaultTZ string) *GetReadingProgressUseCase {
+func NewGetReadingProgressUseCase(repo Repository, userRepo appUser.Repository, days readingDomain.DayBoundary) *GetReadingProgressUseCase {
 	return &GetReadingProgressUseCase{
-		repo:        repo,
-		userRepo:    userRepo,
-		defaultTZ:   defaultTZ,
-		graceHour:   2,
-		goalDefault: 5,
-		clock:       time.Now,
+		repo:      repo,
+		userRepo:  userRepo,
+		days:      days,
+		graceHour: 2,
+		clock:     time.Now,
 	}
 }
 
@@ -42,13 +40,9 @@ func (uc *GetReadingProgressUseCase) Execute(ctx context.Context, in GetReadingP
 
 	userID := user.ID
 
-	loc, err := time.LoadLocation(uc.defaultTZ)
-	if err != nil {
-		return GetReadingProgressOutput{}, err
-	}
-	now := uc.clock().In(loc)
+	now := uc.days.In(uc.clock())
 
-	realDate := readingDomain.DateOf(now, loc)
+	realDate := uc.days.DateOf(now)
 	yesterday := realDate.AddDays(-1)
 
 	var out GetReadingProgressOutput
@@ -60,7 +54,7 @@ func (uc *GetReadingProgressUseCase) Execute(ctx context.Context, in GetReadingP
 		}
 
 		// check if user loses yesterday's reading due to grace period
-		targetDate := readingDomain.TargetDatePolicy{GraceHour: uc.graceHour}.Resolve(now, loc, hasYesterday)
+		targetDate := readingDomain.TargetDatePolicy{GraceHour: uc.graceHour}.Resolve(now, uc.days, hasYesterday)
 
 		day, found, err := uc.repo.GetDay(ctx, tx, userID, targetDate)
 		if err != nil {
@@ -72,22 +66,16 @@ func (uc *GetReadingProgressUseCase) Execute(ctx context.Context, in GetReadingP
 			return err
 		}
 		if !hasGoal {
-			goal = uc.goalDefault
+			goal = readingDomain.DefaultDailyGoal
 		}
 
-		currentGoal := &GoalRecord{
-			DailyPages: goal,
-			ValidFrom:  targetDate.String(),
-		}
+		currentGoal := newGoalRecord(goal, targetDate)
 
 		nextGoal := uc.buildNextGoal(ctx, tx, userID, realDate, goal)
 
 		streak := 0
-		pagesToday := 0
-
 		if found {
 			streak = day.StreakDays
-			pagesToday = day.Pages
 		} else {
 			last, hasLast, err := uc.repo.GetLastDayBefore(ctx, tx, userID, targetDate)
 			if err != nil {
@@ -107,20 +95,23 @@ func (uc *GetReadingProgressUseCase) Execute(ctx context.Context, in GetReadingP
 		week := make([]readingDomain.WeekDayProgress, 0, 7)
 		for i := 0; i < 7; i++ {
 			d := start.AddDays(i)
-			p := byDate[d]
+			r := byDate[d]
 			week = append(week, readingDomain.WeekDayProgress{
 				Date:    d.String(),
-				Pages:   p,
-				Checked: p > 0,
+				Pages:   r.Pages,
+				Minutes: r.Minutes,
+				Checked: r.Pages > 0 || r.Minutes > 0,
 			})
 		}
 
 		out = GetReadingProgressOutput{
 			Progress: readingDomain.ReadingProgress{
 				Day: readingDomain.DayProgress{
-					Date:      targetDate.String(),
-					Pages:     pagesToday,
-					GoalPages: goal,
+					Date:        targetDate.String(),
+					Pages:       day.Pages,
+					Minutes:     day.Minutes,
+					GoalPages:   goal.Pages,
+					GoalMinutes: goal.Minutes,
 				},
 				Streak: readingDomain.StreakProgress{
 					CurrentDays: streak,
@@ -137,18 +128,15 @@ func (uc *GetReadingProgressUseCase) Execute(ctx context.Context, in GetReadingP
 	return out, err
 }
 
-func (uc *GetReadingProgressUseCase) buildNextGoal(ctx context.Context, tx pgx.Tx, userID string, targetDate readingDomain.LocalDate, currentGoalPages int) *GoalRecord {
+func (uc *GetReadingProgressUseCase) buildNextGoal(ctx context.Context, tx pgx.Tx, userID string, targetDate readingDomain.LocalDate, current readingDomain.DailyGoal) *GoalRecord {
 	nextDate := targetDate.AddDays(1)
-	nextGoalPages, hasNextGoal, _ := uc.repo.GetNextGoal(ctx, tx, userID, nextDate)
+	next, hasNextGoal, _ := uc.repo.GetNextGoal(ctx, tx, userID, nextDate)
 	if !hasNextGoal {
-		nextGoalPages = 0
+		next = readingDomain.DailyGoal{}
 	}
 
-	if nextGoalPages != currentGoalPages {
-		return &GoalRecord{
-			DailyPages: nextGoalPages,
-			ValidFrom:  nextDate.String(),
-		}
+	if next != current {
+		return newGoalRecord(next, nextDate)
 	}
 
 	return nil
@@ -0,0 +1,84 @@
+package reading
+
+import (
+	"context"
+	"time"
+
+	appUser "reading-cats-api/internal/application/user"
+	readingDomain "reading-cats-api/internal/domain/reading"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ListReadingLogsUseCase lista os registros individuais de um dia, com o total do dia.
+type ListReadingLogsUseCase struct {
+	repo      Repository
+	userRepo  appUser.Repository
+	days      readingDomain.DayBoundary
+	graceHour int
+	clock     func() time.Time
+}
+
+func NewListReadingLogsUseCase(repo Repository, userRepo appUser.Repository, days readingDomain.DayBoundary) *ListReadingLogsUseCase {
+	return &ListReadingLogsUseCase{
+		repo:      repo,
+		userRepo:  userRepo,
+		days:      days,
+		graceHour: 2,
+		clock:     time.Now,
+	}
+}
+
+func (uc *ListReadingLogsUseCase) Execute(ctx context.Context, in ListReadingLogsInput) (ListReadingLogsOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return ListReadingLogsOutput{}, err
+	}
+	if user == nil {
+		return ListReadingLogsOutput{}, ErrUserNotFound
+	}
+
+	var out ListReadingLogsOutput
+
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		date := in.Date
+		if date == "" {
+			// mesmo dia que o POST usaria agora (com a hora de tolerância)
+			now := uc.days.In(uc.clock())
+			hasYesterday, err := uc.repo.ExistsDay(ctx, tx, user.ID, uc.days.DateOf(now).AddDays(-1))
+			if err != nil {
+				return err
+			}
+			date = readingDomain.TargetDatePolicy{GraceHour: uc.graceHour}.Resolve(now, uc.days, hasYesterday)
+		}
+
+		day, _, err := uc.repo.GetDay(ctx, tx, user.ID, date)
+		if err != nil {
+			return err
+		}
+
+		entries, err := uc.repo.ListLogs(ctx, tx, user.ID, date)
+		if err != nil {
+			return err
+		}
+
+		out = ListReadingLogsOutput{
+			Date:         date.String(),
+			PagesTotal:   day.Pages,
+			MinutesTotal: day.Minutes,
+			Entries:      make([]ReadingLogOutput, 0, len(entries)),
+		}
+		for _, e := range entries {
+			out.Entries = append(out.Entries, ReadingLogOutput{
+				ID:       e.ID,
+				Pages:    e.Pages,
+				Minutes:  e.Minutes,
+				Source:   e.Source.String(),
+				LoggedAt: e.LoggedAt.UTC().Format(time.RFC3339),
+			})
+		}
+		return nil
+	})
+
+	return out, err
+}
@@ -11,22 +11,22 @@ import (
 )
 
 type RegisterReadingUseCase struct {
-	repo        Repository
-	userRepo    appUser.Repository
-	defaultTZ   string
-	graceHour   int
-	goalDefault int
-	clock       func() time.Time
+	repo      Repository
+	userRepo  appUser.Repository
+	hook      CheckinHook
+	days      readingDomain.DayBoundary
+	graceHour int
+	clock     func() time.Time
 }
 
-func NewRegisterReadingUseCase(repo Repository, userRepo appUser.Repository, defaultTZ string) *RegisterReadingUseCase {
+func NewRegisterReadingUseCase(repo Repository, userRepo appUser.Repository, hook CheckinHook, days readingDomain.DayBoundary) *RegisterReadingUseCase {
 	return &RegisterReadingUseCase{
-		repo:        repo,
-		userRepo:    userRepo,
-		defaultTZ:   defaultTZ,
-		graceHour:   2,
-		goalDefault: 5,
-		clock:       time.Now,
+		repo:      repo,
+		userRepo:  userRepo,
+		hook:      hook,
+		days:      days,
+		graceHour: 2,
+		clock:     time.Now,
 	}
 }
 
@@ -41,13 +41,9 @@ func (uc *RegisterReadingUseCase) Execute(ctx context.Context, in RegisterReadin
 	}
 
 	userID := user.ID
-	loc, err := time.LoadLocation(uc.defaultTZ)
-	if err != nil {
-		return RegisterReadingOutput{}, err
-	}
-	now := uc.clock().In(loc)
+	now := uc.days.In(uc.clock())
 
-	realDate := readingDomain.DateOf(now, loc)
+	realDate := uc.days.DateOf(now)
 	yesterday := realDate.AddDays(-1)
 
 	var out RegisterReadingOutput
@@ -58,7 +54,7 @@ func (uc *RegisterReadingUseCase) Execute(ctx context.Context, in RegisterReadin
 			return err
 		}
 
-		targetDate := readingDomain.TargetDatePolicy{GraceHour: uc.graceHour}.Resolve(now, loc, hasYesterday)
+		targetDate := readingDomain.TargetDatePolicy{GraceHour: uc.graceHour}.Resolve(now, uc.days, hasYesterday)
 
 		day, found, err := uc.repo.GetDay(ctx, tx, userID, targetDate)
 		if err != nil {
@@ -66,11 +62,15 @@ func (uc *RegisterReadingUseCase) Execute(ctx context.Context, in RegisterReadin
 		}
 
 		if found {
-			// adicionar páginas no mesmo dia, streak não muda
-			day, err = uc.repo.AddPages(ctx, tx, userID, targetDate, int(in.Pages))
+			// somar páginas/minutos no mesmo dia, streak não muda
+			day, err = uc.repo.AddReading(ctx, tx, userID, targetDate, int(in.Pages), int(in.Minutes))
 			if err != nil {
 				return err
 			}
+			// o dia precisa continuar corrigível: a soma não passa do teto do dia (a tx desfaz)
+			if _, _, err := readingDomain.NewDayTotals(day.Pages, day.Minutes); err != nil {
+				return err
+			}
 		} else {
 			last, hasLast, err := uc.repo.GetLastDayBefore(ctx, tx, userID, targetDate)
 			if err != nil {
@@ -86,18 +86,52 @@ func (uc *RegisterReadingUseCase) Execute(ctx context.Context, in RegisterReadin
 
 			newStreak := readingDomain.StreakPolicy{}.Next(targetDate, lastDate, lastStreak, hasLast)
 
-			day, err = uc.repo.InsertDay(ctx, tx, userID, targetDate, int(in.Pages), int(newStreak))
+			day, err = uc.repo.InsertDay(ctx, tx, userID, targetDate, int(in.Pages), int(in.Minutes), int(newStreak))
 			if err != nil {
 				return err
 			}
 		}
 
+		source := in.Source
+		if source == "" {
+			source = readingDomain.DefaultSource
+		}
+		err = uc.repo.InsertLog(ctx, tx, userID, &readingDomain.LogEntry{
+			UserCheckinID: day.ID,
+			Date:          targetDate,
+			Pages:         int(in.Pages),
+			Minutes:       int(in.Minutes),
+			Source:        source,
+			LoggedAt:      day.LoggedAt,
+		})
+		if err != nil {
+			return err
+		}
+
 		goal, hasGoal, err := uc.repo.GetCurrentGoal(ctx, tx, userID)
 		if err != nil {
 			return err
 		}
 		if !hasGoal {
-			goal = uc.goalDefault
+			goal = readingDomain.DefaultDailyGoal
+		}
+
+		if uc.hook != nil {
+			err := uc.hook.AfterCheckin(ctx, tx, readingDomain.Checkin{
+				UserID:        userID,
+				UserCheckinID: day.ID,
+				Date:          targetDate,
+				FirstLoggedAt: day.FirstLoggedAt,
+				At:            now,
+				Pages:         day.Pages,
+				Minutes:       day.Minutes,
+				StreakDays:    readingDomain.StreakDays(day.StreakDays),
+				Goal:          goal,
+				NewDay:        !found,
+			})
+			if err != nil {
+				return err
+			}
 		}
 
 		start := targetDate.AddDays(-6)
@@ -109,20 +143,23 @@ func (uc *RegisterReadingUseCase) Execute(ctx context.Context, in RegisterReadin
 		week := make([]readingDomain.WeekDayProgress, 0, 7)
 		for i := 0; i < 7; i++ {
 			d := start.AddDays(i)
-			p := byDate[d]
+			r := byDate[d]
 			week = append(week, readingDomain.WeekDayProgress{
 				Date:    d.String(),
-				Pages:   p,
-				Checked: p > 0,
+				Pages:   r.Pages,
+				Minutes: r.Minutes,
+				Checked: r.Pages > 0 || r.Minutes > 0,
 			})
 		}
 
 		out = RegisterReadingOutput{
 			Progress: readingDomain.ReadingProgress{
 				Day: readingDomain.DayProgress{
-					Date:      targetDate.String(),
-					Pages:     day.Pages,
-					GoalPages: goal,
+					Date:        targetDate.String(),
+					Pages:       day.Pages,
+					Minutes:     day.Minutes,
+					GoalPages:   goal.Pages,
+					GoalMinutes: goal.Minutes,
 				},
 				Streak: readingDomain.StreakProgress{
 					CurrentDays: day.StreakDays,
@@ -3,18 +3,27 @@ package reading
 import (
 	"context"
 	"errors"
+	"time"
 
 	readingDomain "reading-cats-api/internal/domain/reading"
 
 	"github.com/jackc/pgx/v5"
 )
 
-var ErrUserNotFound = errors.New("user not found")
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrReadingDayNotFound = errors.New("reading day not found")
+)
 
 type DayRow struct {
+	ID         string // user_checkins.id
 	Date       readingDomain.LocalDate
 	Pages      int
+	Minutes    int
 	StreakDays int
+	LoggedAt   time.Time // último registro de leitura do dia; a janela de correção conta daqui
+	// FirstLoggedAt é o primeiro registro (user_checkins.created_at); fixa o dia nas seasons.
+	FirstLoggedAt time.Time
 }
 
 type LastDayRow struct {
@@ -22,6 +31,20 @@ type LastDayRow struct {
 	StreakDays int
 }
 
+// CheckinHook é chamado dentro da transação do registro de leitura, depois que o dia foi gravado.
+// Se ele falhar, o registro inteiro é desfeito.
+type CheckinHook interface {
+	AfterCheckin(ctx context.Context, tx pgx.Tx, c readingDomain.Checkin) error
+}
+
+// CheckinEditGuard decide se um dia ainda pode ser corrigido ou apagado e, depois da
+// correção, reavalia o dia nas seasons. Quem conhece as seasons em que o dia contou é o
+// grupo; sem guard vale a janela padrão.
+type CheckinEditGuard interface {
+	EnsureEditable(ctx context.Context, tx pgx.Tx, userCheckinID string, loggedAt time.Time, now time.Time) error
+	AfterEdit(ctx context.Context, tx pgx.Tx, c readingDomain.Checkin) error
+}
+
 type Repository interface {
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
 
@@ -29,13 +52,21 @@ type Repository interface {
 	ExistsDay(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (bool, error)
 	GetDay(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (DayRow, bool, error)
 	GetLastDayBefore(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (LastDayRow, bool, error)
-	GetCurrentGoal(ctx context.Context, tx pgx.Tx, userID string) (int, bool, error)
-	GetNextGoal(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (int, bool, error)
-	GetDaysBetween(ctx context.Context, tx pgx.Tx, userID string, start, end readingDomain.LocalDate) (map[readingDomain.LocalDate]int, error)
+	GetCurrentGoal(ctx context.Context, tx pgx.Tx, userID string) (readingDomain.DailyGoal, bool, error)
+	GetNextGoal(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (readingDomain.DailyGoal, bool, error)
+	// GetGoalOn devolve a meta em vigor na data (a mais recente com start_date <= date).
+	GetGoalOn(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (readingDomain.DailyGoal, bool, error)
+	GetDaysBetween(ctx context.Context, tx pgx.Tx, userID string, start, end readingDomain.LocalDate) (map[readingDomain.LocalDate]DayRow, error)
+	// ListLogs devolve os registros do dia em ordem de logged_at.
+	ListLogs(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) ([]readingDomain.LogEntry, error)
 
 	// writes
-	AddPages(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, delta int) (DayRow, error)
-	InsertDay(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, pagesTotal int, streakDays int) (DayRow, error)
-	InsertGoal(ctx context.Context, tx pgx.Tx, userID string, pages int, startDate readingDomain.LocalDate) error
-	UpdateGoalPages(ctx context.Context, tx pgx.Tx, userID string, pages int, startDate readingDomain.LocalDate) error
+	AddReading(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, pages int, minutes int) (DayRow, error)
+	InsertDay(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, pagesTotal int, minutesTotal int, streakDays int) (DayRow, error)
+	SetDayTotals(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, pagesTotal int, minutesTotal int) (DayRow, error)
+	DeleteDay(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) error
+	// InsertLog grava um registro do dia (mesma transação que atualiza o total); preenche e.ID.
+	InsertLog(ctx context.Context, tx pgx.Tx, userID string, e *readingDomain.LogEntry) error
+	InsertGoal(ctx context.Context, tx pgx.Tx, userID string, goal readingDomain.DailyGoal, startDate readingDomain.LocalDate) error
+	UpdateGoal(ctx context.Context, tx pgx.Tx, userID string, goal readingDomain.DailyGoal, startDate readingDomain.LocalDate) error
 }
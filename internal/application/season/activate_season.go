@@ -0,0 +1,124 @@
+package season
+
+import (
+	"context"
+	"time"
+
+	appGroup "reading-cats-api/internal/application/group"
+	appUser "reading-cats-api/internal/application/user"
+	domainGroup "reading-cats-api/internal/domain/group"
+	domainSeason "reading-cats-api/internal/domain/season"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ActivateSeasonUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *appGroup.Policy
+	groups   GroupLocker
+	events   EventRecorder
+	clock    func() time.Time
+}
+
+func NewActivateSeasonUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy, groups GroupLocker, events EventRecorder) *ActivateSeasonUseCase {
+	return &ActivateSeasonUseCase{repo: repo, userRepo: userRepo, policy: policy, groups: groups, events: events, clock: time.Now}
+}
+
+func (uc *ActivateSeasonUseCase) Execute(ctx context.Context, in ActivateSeasonInput) (CreateSeasonOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return CreateSeasonOutput{}, err
+	}
+	if user == nil {
+		return CreateSeasonOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanManageSeasons(ctx, in.GroupID, user.ID); err != nil {
+		return CreateSeasonOutput{}, err
+	}
+
+	var out CreateSeasonOutput
+
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := lockActiveGroup(ctx, uc.groups, tx, in.GroupID); err != nil {
+			return err
+		}
+
+		s, err := uc.repo.LockByID(ctx, tx, in.SeasonID)
+		if err != nil {
+			return err
+		}
+		if s == nil || s.GroupID != in.GroupID {
+			return ErrSeasonNotFound
+		}
+
+		if err := s.Activate(uc.clock().UTC()); err != nil {
+			return err
+		}
+
+		if err := uc.ensureTeamsUpToDate(ctx, tx, s.ID, s.GroupID); err != nil {
+			return err
+		}
+
+		// o índice parcial idx_group_seasons_one_active_per_group garante uma ACTIVE por grupo
+		updatedAt, err := uc.repo.UpdateState(ctx, tx, s)
+		if err != nil {
+			return err
+		}
+		s.UpdatedAt = updatedAt
+
+		if err := uc.repo.SnapshotParticipants(ctx, tx, s); err != nil {
+			return err
+		}
+
+		e := domainGroup.NewEvent(uuid.NewString(), s.GroupID, domainGroup.EventSeasonStarted, user.ID).
+			WithSeason(s.ID)
+		if err := uc.events.InsertEvent(ctx, tx, e); err != nil {
+			return err
+		}
+
+		out = toSeasonOutput(s)
+		return nil
+	})
+	if err != nil {
+		return CreateSeasonOutput{}, err
+	}
+
+	return out, nil
+}
+
+// ensureTeamsUpToDate: numa season de times, quem entrou ou saiu do grupo depois da
+// divisão deixaria o placar de times incompleto; a divisão precisa ser refeita antes.
+func (uc *ActivateSeasonUseCase) ensureTeamsUpToDate(ctx context.Context, tx pgx.Tx, seasonID, groupID string) error {
+	teams, err := uc.repo.ListTeamsTx(ctx, tx, seasonID)
+	if err != nil {
+		return err
+	}
+	if len(teams) == 0 {
+		return nil
+	}
+
+	members, err := uc.repo.ListActiveMemberIDs(ctx, tx, groupID)
+	if err != nil {
+		return err
+	}
+	if err := domainSeason.ValidateTeams(teams, members); err != nil {
+		return domainSeason.ErrTeamsOutOfDate
+	}
+	return nil
+}
+
+// lockActiveGroup trava o grupo antes da season (mesma ordem do arquivamento) e refaz a
+// checagem de arquivamento que a Policy fez fora da transação.
+func lockActiveGroup(ctx context.Context, groups GroupLocker, tx pgx.Tx, groupID string) error {
+	g, err := groups.LockGroup(ctx, tx, groupID)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return appGroup.ErrGroupNotFound
+	}
+	return g.EnsureNotArchived()
+}
@@ -0,0 +1,115 @@
+package season
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type CreateNextSeasonsOutput struct {
+	Created []string `json:"created"`
+}
+
+// CreateNextSeasonsUseCase cria a próxima season dos grupos com template cuja season terminou.
+// Roda no job agendado logo depois de EndExpiredSeasonsUseCase.
+//
+// É idempotente: o template é travado e o grupo reavaliado na transação (season DRAFT/ACTIVE
+// existente = nada a fazer), e o índice único (template_id, period_start) segura o resto.
+type CreateNextSeasonsUseCase struct {
+	repo   Repository
+	events EventRecorder
+	clock  func() time.Time
+}
+
+func NewCreateNextSeasonsUseCase(repo Repository, events EventRecorder) *CreateNextSeasonsUseCase {
+	return &CreateNextSeasonsUseCase{repo: repo, events: events, clock: time.Now}
+}
+
+func (uc *CreateNextSeasonsUseCase) Execute(ctx context.Context) (CreateNextSeasonsOutput, error) {
+	now := uc.clock().UTC()
+
+	ids, err := uc.repo.ListDueTemplateIDs(ctx)
+	if err != nil {
+		return CreateNextSeasonsOutput{}, err
+	}
+
+	out := CreateNextSeasonsOutput{Created: []string{}}
+	var errs []error
+	for _, id := range ids {
+		seasonID, err := uc.createOne(ctx, id, now)
+		if err != nil {
+			// um template com problema não pode travar os outros
+			log.Printf("[season] failed to create season from template %s: %v", id, err)
+			errs = append(errs, fmt.Errorf("template %s: %w", id, err))
+			continue
+		}
+		if seasonID != "" {
+			out.Created = append(out.Created, seasonID)
+		}
+	}
+
+	return out, errors.Join(errs...)
+}
+
+func (uc *CreateNextSeasonsUseCase) createOne(ctx context.Context, templateID string, now time.Time) (string, error) {
+	var created string
+
+	err := uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		t, err := uc.repo.LockTemplate(ctx, tx, templateID)
+		if err != nil {
+			return err
+		}
+		// apagado ou grupo arquivado entre a listagem e o lock
+		if t == nil {
+			return nil
+		}
+
+		open, err := uc.repo.HasOpenSeason(ctx, tx, t.GroupID)
+		if err != nil || open {
+			return err
+		}
+		prevEnd, err := uc.repo.LastEndedAt(ctx, tx, t.GroupID)
+		if err != nil || prevEnd == nil {
+			return err
+		}
+
+		start, end, err := t.NextPeriod(*prevEnd, now)
+		if err != nil {
+			return err
+		}
+		s, err := t.NextSeason(uuid.NewString(), end, now)
+		if err != nil {
+			return err
+		}
+
+		inserted, err := uc.repo.InsertFromTemplate(ctx, tx, s, t.ID, start)
+		if err != nil || !inserted {
+			return err
+		}
+
+		if s.StartedAt != nil {
+			if err := uc.repo.SnapshotParticipants(ctx, tx, s); err != nil {
+				return err
+			}
+
+			e := domainGroup.NewEvent(uuid.NewString(), s.GroupID, domainGroup.EventSeasonStarted, "").
+				WithSeason(s.ID).
+				With("reason", "TEMPLATE")
+			if err := uc.events.InsertEvent(ctx, tx, e); err != nil {
+				return err
+			}
+		}
+
+		created = s.ID
+		return nil
+	})
+
+	return created, err
+}
@@ -5,19 +5,23 @@ import (
 	"fmt"
 	"time"
 
+	appGroup "reading-cats-api/internal/application/group"
 	appUser "reading-cats-api/internal/application/user"
 	domainSeason "reading-cats-api/internal/domain/season"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type CreateSeasonUseCase struct {
 	repo     Repository
 	userRepo appUser.Repository
+	policy   *appGroup.Policy
+	groups   GroupLocker
 }
 
-func NewCreateSeasonUseCase(repo Repository, userRepo appUser.Repository) *CreateSeasonUseCase {
-	return &CreateSeasonUseCase{repo: repo, userRepo: userRepo}
+func NewCreateSeasonUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy, groups GroupLocker) *CreateSeasonUseCase {
+	return &CreateSeasonUseCase{repo: repo, userRepo: userRepo, policy: policy, groups: groups}
 }
 
 func (uc *CreateSeasonUseCase) Execute(ctx context.Context, in CreateSeasonInput) (CreateSeasonOutput, error) {
@@ -28,7 +32,12 @@ func (uc *CreateSeasonUseCase) Execute(ctx context.Context, in CreateSeasonInput
 	}
 
 	if user == nil {
-		return CreateSeasonOutput{}, fmt.Errorf("user not found")
+		return CreateSeasonOutput{}, ErrUserNotFound
+	}
+
+	// Só admins do grupo criam seasons; grupo inexistente ou sem vínculo vira 404
+	if _, err := uc.policy.CanManageSeasons(ctx, in.GroupID, user.ID); err != nil {
+		return CreateSeasonOutput{}, err
 	}
 
 	// Validate timezone
@@ -37,6 +46,14 @@ func (uc *CreateSeasonUseCase) Execute(ctx context.Context, in CreateSeasonInput
 		return CreateSeasonOutput{}, err
 	}
 
+	metric := domainSeason.MetricCheckinsPerDay
+	if in.Metric != "" {
+		metric, err = domainSeason.NewMetric(in.Metric)
+		if err != nil {
+			return CreateSeasonOutput{}, err
+		}
+	}
+
 	// Parse ends_at if provided
 	var endsAt *time.Time
 	if in.EndsAt != nil && *in.EndsAt != "" {
@@ -57,32 +74,75 @@ func (uc *CreateSeasonUseCase) Execute(ctx context.Context, in CreateSeasonInput
 		nil,    // startedAt - set later when activated
 		endsAt, // endsAt - defined at creation
 		timezone,
-		domainSeason.MetricCheckinsPerDay,
+		metric,
 		user.ID,
 		now,
 	)
-
-	// Insert season
-	if err := uc.repo.Insert(ctx, s); err != nil {
-		return CreateSeasonOutput{}, fmt.Errorf("failed to insert season: %w", err)
+	if in.EditWindowMinutes != nil {
+		if err := s.ChangeEditWindow(*in.EditWindowMinutes); err != nil {
+			return CreateSeasonOutput{}, err
+		}
+	}
+	qualification, err := domainSeason.NewQualification(in.MinPages, in.MinMinutes, in.RequireGoal)
+	if err != nil {
+		return CreateSeasonOutput{}, err
+	}
+	if err := s.ChangeQualification(qualification); err != nil {
+		return CreateSeasonOutput{}, err
+	}
+	if in.LateJoinPolicy != "" {
+		policy, err := domainSeason.NewLateJoinPolicy(in.LateJoinPolicy)
+		if err != nil {
+			return CreateSeasonOutput{}, err
+		}
+		if err := s.ChangeLateJoinPolicy(policy); err != nil {
+			return CreateSeasonOutput{}, err
+		}
 	}
 
-	// Build output
-	output := CreateSeasonOutput{
-		ID:              s.ID,
-		GroupID:         s.GroupID,
-		Status:          s.Status.String(),
-		Timezone:        string(s.Timezone),
-		Metric:          s.Metric.String(),
-		CreatedByUserID: s.CreatedByUserID,
-		CreatedAt:       s.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:       s.UpdatedAt.Format(time.RFC3339),
+	// Insert season com o grupo travado: um arquivamento concorrente não deixa season nova pra trás
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := lockActiveGroup(ctx, uc.groups, tx, in.GroupID); err != nil {
+			return err
+		}
+		if err := uc.repo.Insert(ctx, tx, s); err != nil {
+			return fmt.Errorf("failed to insert season: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return CreateSeasonOutput{}, err
 	}
 
+	return toSeasonOutput(s), nil
+}
+
+func toSeasonOutput(s *domainSeason.Season) CreateSeasonOutput {
+	out := CreateSeasonOutput{
+		ID:                s.ID,
+		GroupID:           s.GroupID,
+		Status:            s.Status.String(),
+		Timezone:          string(s.Timezone),
+		Metric:            s.Metric.String(),
+		EditWindowMinutes: int(s.EditWindow / time.Minute),
+		MinPages:          s.Qualification.MinPages,
+		MinMinutes:        s.Qualification.MinMinutes,
+		RequireGoal:       s.Qualification.RequireGoal,
+		LateJoinPolicy:    s.LateJoinPolicy.String(),
+		CreatedByUserID:   s.CreatedByUserID,
+		CreatedAt:         s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         s.UpdatedAt.Format(time.RFC3339),
+	}
+	if s.StartedAt != nil {
+		formatted := s.StartedAt.Format(time.RFC3339)
+		out.StartedAt = &formatted
+	}
 	if s.EndsAt != nil {
 		formatted := s.EndsAt.Format(time.RFC3339)
-		output.EndsAt = &formatted
+		out.EndsAt = &formatted
 	}
-
-	return output, nil
+	// fuso inválido gravado antes da validação: só omite os dias locais
+	out.LocalStartDate, _ = s.LocalStartDate()
+	out.LocalEndDate, _ = s.LocalEndDate()
+	return out
 }
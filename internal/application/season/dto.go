@@ -1,6 +1,7 @@
 package season
 
 import (
+	domainSeason "reading-cats-api/internal/domain/season"
 	userDomain "reading-cats-api/internal/domain/user"
 )
 
@@ -10,16 +11,214 @@ type CreateSeasonInput struct {
 	StartedAt *string `json:"started_at,omitempty"`
 	EndsAt    *string `json:"ends_at,omitempty"`
 	Timezone  string  `json:"timezone"`
+	Metric    string  `json:"metric,omitempty"`
+	// EditWindowMinutes nil = janela padrão; 0 = check-in travado assim que registrado.
+	EditWindowMinutes *int `json:"edit_window_minutes,omitempty"`
+	// regras de qualificação do dia (0/false = qualquer leitura conta)
+	MinPages    int  `json:"min_pages,omitempty"`
+	MinMinutes  int  `json:"min_minutes,omitempty"`
+	RequireGoal bool `json:"require_goal,omitempty"`
+	// LateJoinPolicy vazio = FROM_JOIN_DATE
+	LateJoinPolicy string `json:"late_join_policy,omitempty"`
 }
 
 type CreateSeasonOutput struct {
-	ID              string  `json:"id"`
-	GroupID         string  `json:"group_id"`
-	Status          string  `json:"status"`
-	EndsAt          *string `json:"ends_at,omitempty"`
-	Timezone        string  `json:"timezone"`
-	Metric          string  `json:"metric"`
-	CreatedByUserID string  `json:"created_by_user_id"`
-	CreatedAt       string  `json:"created_at"`
-	UpdatedAt       string  `json:"updated_at"`
+	ID        string  `json:"id"`
+	GroupID   string  `json:"group_id"`
+	Status    string  `json:"status"`
+	StartedAt *string `json:"started_at,omitempty"`
+	EndsAt    *string `json:"ends_at,omitempty"`
+	Timezone  string  `json:"timezone"`
+	// LocalStartDate/LocalEndDate são os dias de início e fim no fuso da season.
+	LocalStartDate    string `json:"local_start_date,omitempty"`
+	LocalEndDate      string `json:"local_end_date,omitempty"`
+	Metric            string `json:"metric"`
+	EditWindowMinutes int    `json:"edit_window_minutes"`
+	MinPages          int    `json:"min_pages"`
+	MinMinutes        int    `json:"min_minutes"`
+	RequireGoal       bool   `json:"require_goal"`
+	LateJoinPolicy    string `json:"late_join_policy"`
+	CreatedByUserID   string `json:"created_by_user_id"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+}
+
+type ActivateSeasonInput struct {
+	Claims   userDomain.IDPClaims
+	GroupID  string
+	SeasonID string
+}
+
+type GetLeaderboardInput struct {
+	Claims   userDomain.IDPClaims
+	GroupID  string
+	SeasonID string
+}
+
+type LeaderboardOutput struct {
+	SeasonID string `json:"season_id"`
+	Metric   string `json:"metric"`
+	Status   string `json:"status"`
+	// AsOf é o dia de referência (fuso da season) usado pra sequência atual.
+	AsOf    string                   `json:"as_of"`
+	Entries []LeaderboardEntryOutput `json:"entries"`
+	Me      *LeaderboardEntryOutput  `json:"me,omitempty"`
+}
+
+type LeaderboardEntryOutput struct {
+	Rank            int     `json:"rank"`
+	UserID          string  `json:"user_id"`
+	DisplayName     string  `json:"display_name"`
+	AvatarURL       string  `json:"avatar_url,omitempty"`
+	Score           int     `json:"score"`
+	CurrentStreak   int     `json:"current_streak"`
+	LastCheckinDate *string `json:"last_checkin_date,omitempty"`
+	// LateJoin: entrou depois da ativação (com PRORATED, Score já vem projetado)
+	LateJoin bool `json:"late_join,omitempty"`
+}
+
+type GetSeasonResultsInput struct {
+	Claims   userDomain.IDPClaims
+	GroupID  string
+	SeasonID string
+}
+
+type SeasonResultsOutput struct {
+	SeasonID     string  `json:"season_id"`
+	Metric       string  `json:"metric"`
+	EndedAt      *string `json:"ended_at,omitempty"`
+	LocalEndDate string  `json:"local_end_date,omitempty"`
+	// Frozen=false só pra seasons encerradas antes do snapshot existir: o resultado é recalculado.
+	Frozen  bool                 `json:"frozen"`
+	Entries []SeasonResultOutput `json:"entries"`
+	Me      *SeasonResultOutput  `json:"me,omitempty"`
+}
+
+type SeasonResultOutput struct {
+	Rank            int      `json:"rank"`
+	UserID          string   `json:"user_id"`
+	DisplayName     string   `json:"display_name"`
+	AvatarURL       string   `json:"avatar_url,omitempty"`
+	Score           int      `json:"score"`
+	FinalStreak     int      `json:"final_streak"`
+	LongestStreak   int      `json:"longest_streak"`
+	CheckinDays     int      `json:"checkin_days"`
+	BestDayPages    int      `json:"best_day_pages"`
+	BestDayMinutes  int      `json:"best_day_minutes"`
+	LastCheckinDate *string  `json:"last_checkin_date,omitempty"`
+	Podium          bool     `json:"podium"`
+	Winner          bool     `json:"winner"`
+	Highlights      []string `json:"highlights"`
+}
+
+type ListSeasonsInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+	// Status vazio lista todas.
+	Status domainSeason.Status
+	Cursor string
+	Limit  int
+}
+
+type ListSeasonsOutput struct {
+	// Active vem sempre (independe do filtro e da página) pro cliente não precisar de outra chamada.
+	Active     *SeasonSummaryOutput  `json:"active"`
+	Seasons    []SeasonSummaryOutput `json:"seasons"`
+	NextCursor *string               `json:"next_cursor,omitempty"`
+}
+
+type SeasonSummaryOutput struct {
+	CreateSeasonOutput
+	// Winners só existe pra seasons ENDED com snapshot; empate no 1º lugar traz todos.
+	Winners []SeasonWinnerOutput `json:"winners"`
+}
+
+type SeasonWinnerOutput struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	AvatarURL   string `json:"avatar_url,omitempty"`
+	Score       int    `json:"score"`
+}
+
+type PutSeasonTemplateInput struct {
+	Claims       userDomain.IDPClaims
+	GroupID      string
+	Metric       string
+	Timezone     string
+	Period       string
+	PeriodDays   int
+	AutoActivate bool
+	// mesmas regras opcionais de CreateSeasonInput, copiadas pra cada season gerada
+	EditWindowMinutes *int
+	MinPages          int
+	MinMinutes        int
+	RequireGoal       bool
+	LateJoinPolicy    string
+}
+
+type SeasonTemplateInput struct {
+	Claims  userDomain.IDPClaims
+	GroupID string
+}
+
+type SeasonTemplateOutput struct {
+	ID           string `json:"id"`
+	GroupID      string `json:"group_id"`
+	Metric       string `json:"metric"`
+	Timezone     string `json:"timezone"`
+	Period       string `json:"period"`
+	PeriodDays   *int   `json:"period_days,omitempty"`
+	AutoActivate bool   `json:"auto_activate"`
+	// regras aplicadas a cada season gerada
+	EditWindowMinutes int    `json:"edit_window_minutes"`
+	MinPages          int    `json:"min_pages"`
+	MinMinutes        int    `json:"min_minutes"`
+	RequireGoal       bool   `json:"require_goal"`
+	LateJoinPolicy    string `json:"late_join_policy"`
+	CreatedByUserID   string `json:"created_by_user_id"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+}
+
+type AssignTeamsInput struct {
+	Claims   userDomain.IDPClaims
+	GroupID  string
+	SeasonID string
+	Teams    []TeamInput
+	// Balance: o servidor distribui os membros ativos aleatoriamente (Teams só traz os nomes).
+	Balance bool
+}
+
+type TeamInput struct {
+	Name      string
+	MemberIDs []string
+}
+
+type TeamsOutput struct {
+	SeasonID string       `json:"season_id"`
+	Teams    []TeamOutput `json:"teams"`
+}
+
+type TeamOutput struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	MemberIDs []string `json:"member_ids"`
+}
+
+type TeamLeaderboardOutput struct {
+	SeasonID string                       `json:"season_id"`
+	Metric   string                       `json:"metric"`
+	Status   string                       `json:"status"`
+	AsOf     string                       `json:"as_of"`
+	Teams    []TeamLeaderboardEntryOutput `json:"teams"`
+	// MyTeamID é o time de quem consulta (ausente se não está em nenhum).
+	MyTeamID *string `json:"my_team_id,omitempty"`
+}
+
+type TeamLeaderboardEntryOutput struct {
+	Rank    int                      `json:"rank"`
+	TeamID  string                   `json:"team_id"`
+	Name    string                   `json:"name"`
+	Score   int                      `json:"score"`
+	Members []LeaderboardEntryOutput `json:"members"`
 }
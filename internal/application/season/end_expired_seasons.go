@@ -0,0 +1,101 @@
+package season
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type EndExpiredSeasonsOutput struct {
+	Ended []string `json:"ended"`
+}
+
+// EndExpiredSeasonsUseCase encerra as seasons ACTIVE que já passaram do ends_at.
+// É idempotente: cada season é travada e reavaliada na própria transação, então
+// rodar de novo (ou em paralelo) não encerra nada duas vezes.
+type EndExpiredSeasonsUseCase struct {
+	repo   Repository
+	events EventRecorder
+	clock  func() time.Time
+}
+
+func NewEndExpiredSeasonsUseCase(repo Repository, events EventRecorder) *EndExpiredSeasonsUseCase {
+	return &EndExpiredSeasonsUseCase{repo: repo, events: events, clock: time.Now}
+}
+
+func (uc *EndExpiredSeasonsUseCase) Execute(ctx context.Context) (EndExpiredSeasonsOutput, error) {
+	now := uc.clock().UTC()
+
+	ids, err := uc.repo.ListExpiredActiveIDs(ctx, now)
+	if err != nil {
+		return EndExpiredSeasonsOutput{}, err
+	}
+
+	out := EndExpiredSeasonsOutput{Ended: []string{}}
+	var errs []error
+	for _, id := range ids {
+		ended, err := uc.endOne(ctx, id, now)
+		if err != nil {
+			// uma season com problema não pode travar as outras
+			log.Printf("[season] failed to end season %s: %v", id, err)
+			errs = append(errs, fmt.Errorf("season %s: %w", id, err))
+			continue
+		}
+		if ended {
+			out.Ended = append(out.Ended, id)
+		}
+	}
+
+	return out, errors.Join(errs...)
+}
+
+func (uc *EndExpiredSeasonsUseCase) endOne(ctx context.Context, seasonID string, now time.Time) (bool, error) {
+	var ended bool
+
+	err := uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		s, err := uc.repo.LockByID(ctx, tx, seasonID)
+		if err != nil {
+			return err
+		}
+		// encerrada (ou apagada) por outra execução entre a listagem e o lock
+		if s == nil || !s.HasExpired(now) {
+			return nil
+		}
+
+		if err := s.End(now); err != nil {
+			return err
+		}
+		updatedAt, err := uc.repo.UpdateState(ctx, tx, s)
+		if err != nil {
+			return err
+		}
+		s.UpdatedAt = updatedAt
+
+		if err := snapshotResults(ctx, uc.repo, tx, s); err != nil {
+			return err
+		}
+
+		e := domainGroup.NewEvent(uuid.NewString(), s.GroupID, domainGroup.EventSeasonEnded, "").
+			WithSeason(s.ID).
+			With("reason", "ENDS_AT_REACHED")
+		// fuso inválido gravado antes da validação não pode impedir o encerramento
+		if localEndDate, err := s.LocalEndDate(); err == nil {
+			e.With("local_end_date", localEndDate)
+		}
+		if err := uc.events.InsertEvent(ctx, tx, e); err != nil {
+			return err
+		}
+
+		ended = true
+		return nil
+	})
+
+	return ended, err
+}
@@ -0,0 +1,11 @@
+package season
+
+import "errors"
+
+var (
+	ErrUserNotFound   = errors.New("user not found")
+	ErrSeasonNotFound = errors.New("season not found")
+
+	ErrSeasonTemplateNotFound = errors.New("season template not found")
+	ErrSeasonTeamsNotFound    = errors.New("season has no teams")
+)
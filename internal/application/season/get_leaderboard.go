@@ -0,0 +1,87 @@
+package season
+
+import (
+	"context"
+	"time"
+
+	appGroup "reading-cats-api/internal/application/group"
+	appUser "reading-cats-api/internal/application/user"
+	domainSeason "reading-cats-api/internal/domain/season"
+)
+
+type GetLeaderboardUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *appGroup.Policy
+	clock    func() time.Time
+}
+
+func NewGetLeaderboardUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy) *GetLeaderboardUseCase {
+	return &GetLeaderboardUseCase{repo: repo, userRepo: userRepo, policy: policy, clock: time.Now}
+}
+
+func (uc *GetLeaderboardUseCase) Execute(ctx context.Context, in GetLeaderboardInput) (LeaderboardOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return LeaderboardOutput{}, err
+	}
+	if user == nil {
+		return LeaderboardOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanView(ctx, in.GroupID, user.ID); err != nil {
+		return LeaderboardOutput{}, err
+	}
+
+	s, err := uc.repo.FindByID(ctx, in.SeasonID)
+	if err != nil {
+		return LeaderboardOutput{}, err
+	}
+	if s == nil || s.GroupID != in.GroupID {
+		return LeaderboardOutput{}, ErrSeasonNotFound
+	}
+
+	today := referenceDate(s, uc.clock())
+
+	rows, err := uc.repo.ListParticipantStats(ctx, s.GroupID, s.ID)
+	if err != nil {
+		return LeaderboardOutput{}, err
+	}
+
+	stats, profiles := splitParticipants(rows)
+
+	out := LeaderboardOutput{
+		SeasonID: s.ID,
+		Metric:   s.Metric.String(),
+		Status:   s.Status.String(),
+		AsOf:     today.String(),
+		Entries:  make([]LeaderboardEntryOutput, 0, len(rows)),
+	}
+	for _, st := range s.Rank(stats, today) {
+		entry := toLeaderboardEntry(st, profiles)
+		out.Entries = append(out.Entries, entry)
+		if st.UserID == user.ID {
+			me := entry
+			out.Me = &me
+		}
+	}
+
+	return out, nil
+}
+
+func toLeaderboardEntry(st domainSeason.Standing, profiles map[string]ParticipantRow) LeaderboardEntryOutput {
+	entry := LeaderboardEntryOutput{
+		Rank:          st.Rank,
+		UserID:        st.UserID,
+		DisplayName:   profiles[st.UserID].DisplayName,
+		AvatarURL:     profiles[st.UserID].AvatarURL,
+		Score:         st.Score,
+		CurrentStreak: st.CurrentStreak,
+		LateJoin:      st.Late,
+	}
+	if st.LastCheckinDate != "" {
+		last := st.LastCheckinDate.String()
+		entry.LastCheckinDate = &last
+	}
+	return entry
+}
@@ -0,0 +1,108 @@
+package season
+
+import (
+	"context"
+	"time"
+
+	appGroup "reading-cats-api/internal/application/group"
+	appUser "reading-cats-api/internal/application/user"
+	domainSeason "reading-cats-api/internal/domain/season"
+)
+
+type GetSeasonResultsUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *appGroup.Policy
+}
+
+func NewGetSeasonResultsUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy) *GetSeasonResultsUseCase {
+	return &GetSeasonResultsUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+// Execute devolve o resultado congelado de uma season ENDED, com pódio e destaques.
+func (uc *GetSeasonResultsUseCase) Execute(ctx context.Context, in GetSeasonResultsInput) (SeasonResultsOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return SeasonResultsOutput{}, err
+	}
+	if user == nil {
+		return SeasonResultsOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanView(ctx, in.GroupID, user.ID); err != nil {
+		return SeasonResultsOutput{}, err
+	}
+
+	s, err := uc.repo.FindByID(ctx, in.SeasonID)
+	if err != nil {
+		return SeasonResultsOutput{}, err
+	}
+	if s == nil || s.GroupID != in.GroupID {
+		return SeasonResultsOutput{}, ErrSeasonNotFound
+	}
+	if s.Status != domainSeason.StatusEnded {
+		return SeasonResultsOutput{}, domainSeason.ErrSeasonNotEnded
+	}
+
+	results, err := uc.repo.ListResults(ctx, s.ID)
+	if err != nil {
+		return SeasonResultsOutput{}, err
+	}
+	frozen := len(results) > 0
+	if !frozen {
+		// encerrada antes do snapshot existir: recalcula com os dados de hoje
+		rows, err := uc.repo.ListParticipantStats(ctx, s.GroupID, s.ID)
+		if err != nil {
+			return SeasonResultsOutput{}, err
+		}
+		results = computeResults(s, rows)
+	}
+
+	out := SeasonResultsOutput{
+		SeasonID: s.ID,
+		Metric:   s.Metric.String(),
+		Frozen:   frozen,
+		Entries:  make([]SeasonResultOutput, 0, len(results)),
+	}
+	if s.EndsAt != nil {
+		endedAt := s.EndsAt.Format(time.RFC3339)
+		out.EndedAt = &endedAt
+	}
+	// fuso inválido gravado antes da validação: só omite o dia local
+	if localEndDate, err := s.LocalEndDate(); err == nil {
+		out.LocalEndDate = localEndDate
+	}
+
+	highlights := domainSeason.Highlights(results)
+	for _, r := range results {
+		entry := SeasonResultOutput{
+			Rank:           r.Rank,
+			UserID:         r.UserID,
+			DisplayName:    r.DisplayName,
+			AvatarURL:      r.AvatarURL,
+			Score:          r.Score,
+			FinalStreak:    r.FinalStreak,
+			LongestStreak:  r.LongestStreak,
+			CheckinDays:    r.CheckinDays,
+			BestDayPages:   r.BestDay.Pages,
+			BestDayMinutes: r.BestDay.Minutes,
+			Podium:         r.OnPodium(),
+			Winner:         r.IsWinner(),
+			Highlights:     make([]string, 0, len(highlights[r.UserID])),
+		}
+		if r.LastCheckinDate != "" {
+			last := r.LastCheckinDate.String()
+			entry.LastCheckinDate = &last
+		}
+		for _, h := range highlights[r.UserID] {
+			entry.Highlights = append(entry.Highlights, string(h))
+		}
+		out.Entries = append(out.Entries, entry)
+		if r.UserID == user.ID {
+			me := entry
+			out.Me = &me
+		}
+	}
+
+	return out, nil
+}
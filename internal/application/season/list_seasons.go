@@ -0,0 +1,99 @@
+package season
+
+import (
+	"context"
+
+	appGroup "reading-cats-api/internal/application/group"
+	"reading-cats-api/internal/application/pagination"
+	appUser "reading-cats-api/internal/application/user"
+	domainSeason "reading-cats-api/internal/domain/season"
+)
+
+type ListSeasonsUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *appGroup.Policy
+}
+
+func NewListSeasonsUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy) *ListSeasonsUseCase {
+	return &ListSeasonsUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+func (uc *ListSeasonsUseCase) Execute(ctx context.Context, in ListSeasonsInput) (ListSeasonsOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return ListSeasonsOutput{}, err
+	}
+	if user == nil {
+		return ListSeasonsOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanView(ctx, in.GroupID, user.ID); err != nil {
+		return ListSeasonsOutput{}, err
+	}
+
+	after, err := pagination.Decode(in.Cursor)
+	if err != nil {
+		return ListSeasonsOutput{}, err
+	}
+	limit := pagination.NormalizeLimit(in.Limit)
+
+	// busca 1 a mais pra saber se existe próxima página
+	seasons, err := uc.repo.ListByGroup(ctx, in.GroupID, in.Status, after, limit+1)
+	if err != nil {
+		return ListSeasonsOutput{}, err
+	}
+
+	out := ListSeasonsOutput{Seasons: make([]SeasonSummaryOutput, 0, len(seasons))}
+	if len(seasons) > limit {
+		seasons = seasons[:limit]
+		last := seasons[len(seasons)-1]
+		next := pagination.Cursor{At: last.CreatedAt, ID: last.ID}.Encode()
+		out.NextCursor = &next
+	}
+
+	active, err := uc.repo.FindActiveByGroup(ctx, in.GroupID)
+	if err != nil {
+		return ListSeasonsOutput{}, err
+	}
+
+	ended := []string{}
+	for _, s := range seasons {
+		if s.Status == domainSeason.StatusEnded {
+			ended = append(ended, s.ID)
+		}
+	}
+	winners, err := uc.repo.ListWinners(ctx, ended)
+	if err != nil {
+		return ListSeasonsOutput{}, err
+	}
+	bySeason := map[string][]SeasonWinnerOutput{}
+	for _, w := range winners {
+		bySeason[w.SeasonID] = append(bySeason[w.SeasonID], SeasonWinnerOutput{
+			UserID:      w.UserID,
+			DisplayName: w.DisplayName,
+			AvatarURL:   w.AvatarURL,
+			Score:       w.Score,
+		})
+	}
+
+	for _, s := range seasons {
+		out.Seasons = append(out.Seasons, toSeasonSummary(s, bySeason[s.ID]))
+	}
+	if active != nil {
+		summary := toSeasonSummary(active, nil)
+		out.Active = &summary
+	}
+
+	return out, nil
+}
+
+func toSeasonSummary(s *domainSeason.Season, winners []SeasonWinnerOutput) SeasonSummaryOutput {
+	if winners == nil {
+		winners = []SeasonWinnerOutput{}
+	}
+	return SeasonSummaryOutput{
+		CreateSeasonOutput: toSeasonOutput(s),
+		Winners:            winners,
+	}
+}
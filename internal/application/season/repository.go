@@ -2,9 +2,98 @@ package season
 
 import (
 	"context"
+	"time"
+
+	"reading-cats-api/internal/application/pagination"
+	domainGroup "reading-cats-api/internal/domain/group"
 	domainSeason "reading-cats-api/internal/domain/season"
+
+	"github.com/jackc/pgx/v5"
 )
 
+// ParticipantRow são as estatísticas de um participante com os dados de perfil.
+type ParticipantRow struct {
+	Stats       domainSeason.ParticipantStats
+	DisplayName string
+	AvatarURL   string
+}
+
+// WinnerRow é quem terminou em 1º (com pontuação) no snapshot de uma season.
+type WinnerRow struct {
+	SeasonID    string
+	UserID      string
+	DisplayName string
+	AvatarURL   string
+	Score       int
+}
+
 type Repository interface {
-	Insert(ctx context.Context, s *domainSeason.Season) error
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
+
+	Insert(ctx context.Context, tx pgx.Tx, s *domainSeason.Season) error
+	// FindByID retorna nil quando a season não existe.
+	FindByID(ctx context.Context, seasonID string) (*domainSeason.Season, error)
+	// FindActiveByGroup retorna nil quando o grupo não tem season ACTIVE.
+	FindActiveByGroup(ctx context.Context, groupID string) (*domainSeason.Season, error)
+	// ListByGroup pagina as seasons do grupo por (created_at DESC, id DESC); status vazio = todas.
+	ListByGroup(ctx context.Context, groupID string, status domainSeason.Status, after *pagination.Cursor, limit int) ([]*domainSeason.Season, error)
+	// ListWinners lê os vencedores (rank 1) do snapshot das seasons informadas; empates vêm todos.
+	ListWinners(ctx context.Context, seasonIDs []string) ([]WinnerRow, error)
+	// ListParticipantStats agrega group_checkins da season numa única query: todo o roster
+	// (season_participants) entra, mesmo sem check-in ou depois de sair do grupo.
+	// Season DRAFT ainda não tem roster: entram os membros ativos.
+	ListParticipantStats(ctx context.Context, groupID string, seasonID string) ([]ParticipantRow, error)
+	ListParticipantStatsTx(ctx context.Context, tx pgx.Tx, groupID string, seasonID string) ([]ParticipantRow, error)
+	// ListExpiredActiveIDs lista as seasons ACTIVE com ends_at <= now.
+	ListExpiredActiveIDs(ctx context.Context, now time.Time) ([]string, error)
+	// LockByID lê a season com FOR UPDATE; nil quando não existe.
+	LockByID(ctx context.Context, tx pgx.Tx, seasonID string) (*domainSeason.Season, error)
+	// UpdateState grava status/started_at/ends_at e devolve o updated_at gerado pelo trigger.
+	// Uma segunda season ACTIVE no mesmo grupo vira domainSeason.ErrActiveSeasonExists.
+	UpdateState(ctx context.Context, tx pgx.Tx, s *domainSeason.Season) (time.Time, error)
+
+	// InsertResults grava o snapshot final; é idempotente por (season, usuário).
+	InsertResults(ctx context.Context, tx pgx.Tx, seasonID string, results []domainSeason.Result) error
+	// ListResults devolve o snapshot em ordem de posição; vazio se a season não foi congelada.
+	ListResults(ctx context.Context, seasonID string) ([]domainSeason.Result, error)
+
+	// SnapshotParticipants grava o roster da season recém-ativada (membros ativos do grupo).
+	SnapshotParticipants(ctx context.Context, tx pgx.Tx, s *domainSeason.Season) error
+
+	// ReplaceTeams substitui a divisão em times da season (IDs e CreatedAt já preenchidos).
+	ReplaceTeams(ctx context.Context, tx pgx.Tx, seasonID string, teams []domainSeason.Team) error
+	// ListTeams devolve os times em ordem de nome, com os membros; vazio se a season não é de times.
+	ListTeams(ctx context.Context, seasonID string) ([]domainSeason.Team, error)
+	ListTeamsTx(ctx context.Context, tx pgx.Tx, seasonID string) ([]domainSeason.Team, error)
+	// ListActiveMemberIDs lista os membros ativos do grupo (quem precisa estar num time).
+	ListActiveMemberIDs(ctx context.Context, tx pgx.Tx, groupID string) ([]string, error)
+
+	// SaveTemplate cria ou substitui o template do grupo; preenche ID/CreatedAt/UpdatedAt gravados.
+	SaveTemplate(ctx context.Context, t *domainSeason.Template) error
+	// FindTemplateByGroup retorna nil quando o grupo não tem template.
+	FindTemplateByGroup(ctx context.Context, groupID string) (*domainSeason.Template, error)
+	DeleteTemplate(ctx context.Context, groupID string) (deleted bool, err error)
+	// ListDueTemplateIDs lista templates de grupos não arquivados sem season DRAFT/ACTIVE
+	// e com pelo menos uma ENDED (a próxima começa onde ela terminou).
+	ListDueTemplateIDs(ctx context.Context) ([]string, error)
+	// LockTemplate lê o template com FOR UPDATE; nil quando não existe ou o grupo foi arquivado.
+	LockTemplate(ctx context.Context, tx pgx.Tx, templateID string) (*domainSeason.Template, error)
+	// HasOpenSeason diz se o grupo tem season DRAFT ou ACTIVE.
+	HasOpenSeason(ctx context.Context, tx pgx.Tx, groupID string) (bool, error)
+	// LastEndedAt é o ends_at da última season ENDED do grupo; nil se nunca houve.
+	LastEndedAt(ctx context.Context, tx pgx.Tx, groupID string) (*time.Time, error)
+	// InsertFromTemplate grava a season gerada; inserted=false se o período já tinha season.
+	InsertFromTemplate(ctx context.Context, tx pgx.Tx, s *domainSeason.Season, templateID string, periodStart time.Time) (inserted bool, err error)
+}
+
+// GroupLocker trava o grupo na transação da season (o repositório de grupo implementa):
+// o arquivamento encerra a season ACTIVE com o grupo travado, então criar ou ativar season
+// precisa do mesmo lock pra não escapar dele.
+type GroupLocker interface {
+	LockGroup(ctx context.Context, tx pgx.Tx, groupID string) (*domainGroup.Group, error)
+}
+
+// EventRecorder grava itens do feed do grupo na transação da season (o repositório de grupo implementa).
+type EventRecorder interface {
+	InsertEvent(ctx context.Context, tx pgx.Tx, e *domainGroup.Event) error
 }
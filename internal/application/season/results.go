@@ -0,0 +1,74 @@
+package season
+
+import (
+	"context"
+	"time"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+	domainSeason "reading-cats-api/internal/domain/season"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ResultsSnapshotter congela os resultados de uma season encerrada fora deste pacote
+// (o arquivamento do grupo encerra a season direto no SQL).
+type ResultsSnapshotter struct {
+	repo Repository
+}
+
+func NewResultsSnapshotter(repo Repository) *ResultsSnapshotter {
+	return &ResultsSnapshotter{repo: repo}
+}
+
+// FinalizeSeason roda na transação que encerrou a season; ignora seasons que não estão ENDED.
+func (r *ResultsSnapshotter) FinalizeSeason(ctx context.Context, tx pgx.Tx, seasonID string) error {
+	s, err := r.repo.LockByID(ctx, tx, seasonID)
+	if err != nil {
+		return err
+	}
+	if s == nil || s.Status != domainSeason.StatusEnded {
+		return nil
+	}
+	return snapshotResults(ctx, r.repo, tx, s)
+}
+
+// snapshotResults grava o ranking final de s, que acabou de ser encerrada em tx.
+func snapshotResults(ctx context.Context, repo Repository, tx pgx.Tx, s *domainSeason.Season) error {
+	rows, err := repo.ListParticipantStatsTx(ctx, tx, s.GroupID, s.ID)
+	if err != nil {
+		return err
+	}
+	return repo.InsertResults(ctx, tx, s.ID, computeResults(s, rows))
+}
+
+func computeResults(s *domainSeason.Season, rows []ParticipantRow) []domainSeason.Result {
+	stats, profiles := splitParticipants(rows)
+	// End sempre preenche ends_at; o fallback cobre seasons encerradas antes disso
+	endedAt := s.UpdatedAt
+	if s.EndsAt != nil {
+		endedAt = *s.EndsAt
+	}
+	return s.Results(stats, referenceDate(s, endedAt), func(userID string) (string, string) {
+		return profiles[userID].DisplayName, profiles[userID].AvatarURL
+	})
+}
+
+func splitParticipants(rows []ParticipantRow) ([]domainSeason.ParticipantStats, map[string]ParticipantRow) {
+	stats := make([]domainSeason.ParticipantStats, 0, len(rows))
+	profiles := make(map[string]ParticipantRow, len(rows))
+	for _, row := range rows {
+		stats = append(stats, row.Stats)
+		profiles[row.Stats.UserID] = row
+	}
+	return stats, profiles
+}
+
+// referenceDate é o ReferenceDate da season; fuso inválido gravado antes da validação
+// cai pra UTC em vez de quebrar a leitura.
+func referenceDate(s *domainSeason.Season, now time.Time) readingDomain.LocalDate {
+	today, err := s.ReferenceDate(now)
+	if err != nil {
+		return readingDomain.UTCDays.DateOf(now)
+	}
+	return today
+}
@@ -0,0 +1,171 @@
+package season
+
+import (
+	"context"
+	"time"
+
+	appGroup "reading-cats-api/internal/application/group"
+	appUser "reading-cats-api/internal/application/user"
+	domainSeason "reading-cats-api/internal/domain/season"
+
+	"github.com/google/uuid"
+)
+
+type PutSeasonTemplateUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *appGroup.Policy
+	clock    func() time.Time
+}
+
+func NewPutSeasonTemplateUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy) *PutSeasonTemplateUseCase {
+	return &PutSeasonTemplateUseCase{repo: repo, userRepo: userRepo, policy: policy, clock: time.Now}
+}
+
+// Execute cria ou substitui o template do grupo. Vale a partir do próximo encerramento:
+// a season em andamento não muda.
+func (uc *PutSeasonTemplateUseCase) Execute(ctx context.Context, in PutSeasonTemplateInput) (SeasonTemplateOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return SeasonTemplateOutput{}, err
+	}
+	if user == nil {
+		return SeasonTemplateOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanManageSeasons(ctx, in.GroupID, user.ID); err != nil {
+		return SeasonTemplateOutput{}, err
+	}
+
+	timezone, err := domainSeason.NewTimezone(in.Timezone)
+	if err != nil {
+		return SeasonTemplateOutput{}, err
+	}
+
+	metric := domainSeason.MetricCheckinsPerDay
+	if in.Metric != "" {
+		metric, err = domainSeason.NewMetric(in.Metric)
+		if err != nil {
+			return SeasonTemplateOutput{}, err
+		}
+	}
+
+	t, err := domainSeason.NewTemplate(uuid.NewString(), in.GroupID, metric, timezone, in.Period, in.PeriodDays, in.AutoActivate, user.ID, uc.clock().UTC())
+	if err != nil {
+		return SeasonTemplateOutput{}, err
+	}
+	if in.EditWindowMinutes != nil {
+		t.EditWindow, err = domainSeason.NewEditWindow(*in.EditWindowMinutes)
+		if err != nil {
+			return SeasonTemplateOutput{}, err
+		}
+	}
+	t.Qualification, err = domainSeason.NewQualification(in.MinPages, in.MinMinutes, in.RequireGoal)
+	if err != nil {
+		return SeasonTemplateOutput{}, err
+	}
+	if in.LateJoinPolicy != "" {
+		t.LateJoinPolicy, err = domainSeason.NewLateJoinPolicy(in.LateJoinPolicy)
+		if err != nil {
+			return SeasonTemplateOutput{}, err
+		}
+	}
+
+	if err := uc.repo.SaveTemplate(ctx, t); err != nil {
+		return SeasonTemplateOutput{}, err
+	}
+
+	return toTemplateOutput(t), nil
+}
+
+type GetSeasonTemplateUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *appGroup.Policy
+}
+
+func NewGetSeasonTemplateUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy) *GetSeasonTemplateUseCase {
+	return &GetSeasonTemplateUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+func (uc *GetSeasonTemplateUseCase) Execute(ctx context.Context, in SeasonTemplateInput) (SeasonTemplateOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return SeasonTemplateOutput{}, err
+	}
+	if user == nil {
+		return SeasonTemplateOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanView(ctx, in.GroupID, user.ID); err != nil {
+		return SeasonTemplateOutput{}, err
+	}
+
+	t, err := uc.repo.FindTemplateByGroup(ctx, in.GroupID)
+	if err != nil {
+		return SeasonTemplateOutput{}, err
+	}
+	if t == nil {
+		return SeasonTemplateOutput{}, ErrSeasonTemplateNotFound
+	}
+
+	return toTemplateOutput(t), nil
+}
+
+type DeleteSeasonTemplateUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *appGroup.Policy
+}
+
+func NewDeleteSeasonTemplateUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy) *DeleteSeasonTemplateUseCase {
+	return &DeleteSeasonTemplateUseCase{repo: repo, userRepo: userRepo, policy: policy}
+}
+
+// Execute para a recorrência; seasons já criadas pelo template continuam como estão.
+func (uc *DeleteSeasonTemplateUseCase) Execute(ctx context.Context, in SeasonTemplateInput) error {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanManageSeasons(ctx, in.GroupID, user.ID); err != nil {
+		return err
+	}
+
+	deleted, err := uc.repo.DeleteTemplate(ctx, in.GroupID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrSeasonTemplateNotFound
+	}
+	return nil
+}
+
+func toTemplateOutput(t *domainSeason.Template) SeasonTemplateOutput {
+	out := SeasonTemplateOutput{
+		ID:                t.ID,
+		GroupID:           t.GroupID,
+		Metric:            t.Metric.String(),
+		Timezone:          string(t.Timezone),
+		Period:            t.Period.String(),
+		AutoActivate:      t.AutoActivate,
+		EditWindowMinutes: int(t.EditWindow / time.Minute),
+		MinPages:          t.Qualification.MinPages,
+		MinMinutes:        t.Qualification.MinMinutes,
+		RequireGoal:       t.Qualification.RequireGoal,
+		LateJoinPolicy:    t.LateJoinPolicy.String(),
+		CreatedByUserID:   t.CreatedByUserID,
+		CreatedAt:         t.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         t.UpdatedAt.Format(time.RFC3339),
+	}
+	if t.Period == domainSeason.PeriodDays {
+		days := t.PeriodDays
+		out.PeriodDays = &days
+	}
+	return out
+}
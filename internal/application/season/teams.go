@@ -0,0 +1,170 @@
+package season
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	appGroup "reading-cats-api/internal/application/group"
+	appUser "reading-cats-api/internal/application/user"
+	domainSeason "reading-cats-api/internal/domain/season"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// AssignTeamsUseCase divide os membros ativos em times numa season DRAFT (substitui a divisão anterior).
+type AssignTeamsUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *appGroup.Policy
+	shuffle  func(n int, swap func(i, j int))
+	clock    func() time.Time
+}
+
+func NewAssignTeamsUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy) *AssignTeamsUseCase {
+	return &AssignTeamsUseCase{repo: repo, userRepo: userRepo, policy: policy, shuffle: rand.Shuffle, clock: time.Now}
+}
+
+func (uc *AssignTeamsUseCase) Execute(ctx context.Context, in AssignTeamsInput) (TeamsOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return TeamsOutput{}, err
+	}
+	if user == nil {
+		return TeamsOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanManageSeasons(ctx, in.GroupID, user.ID); err != nil {
+		return TeamsOutput{}, err
+	}
+
+	now := uc.clock().UTC()
+	teams := make([]domainSeason.Team, 0, len(in.Teams))
+	for _, t := range in.Teams {
+		name, err := domainSeason.NewTeamName(t.Name)
+		if err != nil {
+			return TeamsOutput{}, err
+		}
+		teams = append(teams, domainSeason.Team{
+			ID:        uuid.NewString(),
+			SeasonID:  in.SeasonID,
+			Name:      name,
+			MemberIDs: t.MemberIDs,
+			CreatedAt: now,
+		})
+	}
+
+	err = uc.repo.WithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		s, err := uc.repo.LockByID(ctx, tx, in.SeasonID)
+		if err != nil {
+			return err
+		}
+		if s == nil || s.GroupID != in.GroupID {
+			return ErrSeasonNotFound
+		}
+
+		members, err := uc.repo.ListActiveMemberIDs(ctx, tx, s.GroupID)
+		if err != nil {
+			return err
+		}
+		if in.Balance {
+			teams = domainSeason.BalanceTeams(teams, members, uc.shuffle)
+		}
+
+		if err := s.AssignTeams(teams, members); err != nil {
+			return err
+		}
+		return uc.repo.ReplaceTeams(ctx, tx, s.ID, teams)
+	})
+	if err != nil {
+		return TeamsOutput{}, err
+	}
+
+	out := TeamsOutput{SeasonID: in.SeasonID, Teams: make([]TeamOutput, 0, len(teams))}
+	for _, t := range teams {
+		out.Teams = append(out.Teams, TeamOutput{ID: t.ID, Name: t.Name, MemberIDs: t.MemberIDs})
+	}
+	return out, nil
+}
+
+// GetTeamLeaderboardUseCase soma o ranking individual da season por time.
+type GetTeamLeaderboardUseCase struct {
+	repo     Repository
+	userRepo appUser.Repository
+	policy   *appGroup.Policy
+	clock    func() time.Time
+}
+
+func NewGetTeamLeaderboardUseCase(repo Repository, userRepo appUser.Repository, policy *appGroup.Policy) *GetTeamLeaderboardUseCase {
+	return &GetTeamLeaderboardUseCase{repo: repo, userRepo: userRepo, policy: policy, clock: time.Now}
+}
+
+func (uc *GetTeamLeaderboardUseCase) Execute(ctx context.Context, in GetLeaderboardInput) (TeamLeaderboardOutput, error) {
+	user, err := uc.userRepo.FindByCognitoSub(ctx, in.Claims.Sub)
+	if err != nil {
+		return TeamLeaderboardOutput{}, err
+	}
+	if user == nil {
+		return TeamLeaderboardOutput{}, ErrUserNotFound
+	}
+
+	if _, err := uc.policy.CanView(ctx, in.GroupID, user.ID); err != nil {
+		return TeamLeaderboardOutput{}, err
+	}
+
+	s, err := uc.repo.FindByID(ctx, in.SeasonID)
+	if err != nil {
+		return TeamLeaderboardOutput{}, err
+	}
+	if s == nil || s.GroupID != in.GroupID {
+		return TeamLeaderboardOutput{}, ErrSeasonNotFound
+	}
+
+	teams, err := uc.repo.ListTeams(ctx, s.ID)
+	if err != nil {
+		return TeamLeaderboardOutput{}, err
+	}
+	if len(teams) == 0 {
+		return TeamLeaderboardOutput{}, ErrSeasonTeamsNotFound
+	}
+
+	today := referenceDate(s, uc.clock())
+
+	rows, err := uc.repo.ListParticipantStats(ctx, s.GroupID, s.ID)
+	if err != nil {
+		return TeamLeaderboardOutput{}, err
+	}
+
+	stats, profiles := splitParticipants(rows)
+
+	out := TeamLeaderboardOutput{
+		SeasonID: s.ID,
+		Metric:   s.Metric.String(),
+		Status:   s.Status.String(),
+		AsOf:     today.String(),
+		Teams:    make([]TeamLeaderboardEntryOutput, 0, len(teams)),
+	}
+	for _, ts := range domainSeason.RankTeams(teams, s.Rank(stats, today)) {
+		entry := TeamLeaderboardEntryOutput{
+			Rank:    ts.Rank,
+			TeamID:  ts.ID,
+			Name:    ts.Name,
+			Score:   ts.Score,
+			Members: make([]LeaderboardEntryOutput, 0, len(ts.Members)),
+		}
+		for _, st := range ts.Members {
+			entry.Members = append(entry.Members, toLeaderboardEntry(st, profiles))
+		}
+		out.Teams = append(out.Teams, entry)
+
+		for _, id := range ts.MemberIDs {
+			if id == user.ID {
+				teamID := ts.ID
+				out.MyTeamID = &teamID
+			}
+		}
+	}
+
+	return out, nil
+}
@@ -8,6 +8,10 @@ import (
 
 type Config struct {
 	DatabaseURL string
+	// Handler escolhe o entry point do Lambda: "http" (default) ou "scheduled".
+	Handler string
+	// Timezone é o fuso IANA que define o dia do registro de leitura dos usuários.
+	Timezone string
 }
 
 func Load() Config {
@@ -16,6 +20,8 @@ func Load() Config {
 
 	return Config{
 		DatabaseURL: mustEnv("DATABASE_URL"),
+		Handler:     envOr("APP_HANDLER", "http"),
+		Timezone:    envOr("APP_TIMEZONE", "America/Sao_Paulo"),
 	}
 }
 
@@ -26,3 +32,10 @@ func mustEnv(k string) string {
 	}
 	return v
 }
+
+func envOr(k string, def string) string {
+	if v := os.Getenv(k); v != "" {
+		return v
+	}
+	return def
+}
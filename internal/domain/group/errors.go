@@ -3,6 +3,21 @@ package group
 import "errors"
 
 var (
-	ErrInvalidGroupName = errors.New("invalid group name: must be between 1 and 30 characters")
-	ErrInvalidIconID    = errors.New("invalid icon_id: must not be empty")
+	ErrInvalidGroupName  = errors.New("invalid group name: must be between 1 and 30 characters")
+	ErrInvalidIconID     = errors.New("invalid icon_id: must not be empty")
+	ErrInvalidMaxMembers = errors.New("invalid max_members: must be between 1 and 50")
+	ErrMaxMembersTooLow  = errors.New("max_members cannot be lower than the current number of active members")
+	ErrGroupFull         = errors.New("group is full")
+	ErrInvalidInviteCode = errors.New("invalid invite code")
+	ErrInvalidInviteTTL  = errors.New("invalid invite expiration: must be between 1 and 720 hours")
+	ErrInviteExpired     = errors.New("invite expired")
+	ErrInviteAlreadyUsed = errors.New("invite already used")
+	ErrInvalidRole       = errors.New("invalid role: must be ADMIN or MEMBER")
+	ErrLastAdmin         = errors.New("group must keep at least one admin")
+	ErrOwnerMustBeAdmin  = errors.New("the group owner must remain an admin")
+	ErrCannotRemoveOwner = errors.New("the group owner cannot be removed")
+	ErrGroupArchived     = errors.New("group is archived")
+	ErrInvalidVisibility = errors.New("invalid visibility: must be INVITE_ONLY or PUBLIC_SOON")
+
+	ErrJoinRequestNotPending = errors.New("join request was already decided")
 )
@@ -0,0 +1,50 @@
+package group
+
+import "time"
+
+type EventType string
+
+const (
+	EventGroupCreated    EventType = "GROUP_CREATED"
+	EventMemberJoined    EventType = "MEMBER_JOINED"
+	EventMemberLeft      EventType = "MEMBER_LEFT"
+	EventSeasonStarted   EventType = "SEASON_STARTED"
+	EventSeasonEnded     EventType = "SEASON_ENDED"
+	EventMemberCheckedIn EventType = "MEMBER_CHECKED_IN"
+	EventStreakMilestone EventType = "STREAK_MILESTONE"
+)
+
+func (t EventType) String() string {
+	return string(t)
+}
+
+// Event é um item do feed do grupo. ActorUserID/SeasonID vazios viram NULL no banco.
+type Event struct {
+	ID          string
+	GroupID     string
+	Type        EventType
+	ActorUserID string
+	SeasonID    string
+	Data        map[string]any
+	CreatedAt   time.Time
+}
+
+func NewEvent(id string, groupID string, eventType EventType, actorUserID string) *Event {
+	return &Event{
+		ID:          id,
+		GroupID:     groupID,
+		Type:        eventType,
+		ActorUserID: actorUserID,
+		Data:        map[string]any{},
+	}
+}
+
+func (e *Event) WithSeason(seasonID string) *Event {
+	e.SeasonID = seasonID
+	return e
+}
+
+func (e *Event) With(key string, value any) *Event {
+	e.Data[key] = value
+	return e
+}
@@ -11,6 +11,8 @@ type Group struct {
 	CreatedByUserID string
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	// ArchivedAt != nil: grupo congelado, só leitura (histórico).
+	ArchivedAt *time.Time
 }
 
 func New(
@@ -33,3 +35,52 @@ func New(
 		UpdatedAt:       createdAt,
 	}
 }
+
+// EnsureCapacity valida se cabe mais um membro, dado o número atual de membros ativos.
+func (g *Group) EnsureCapacity(activeMembers int) error {
+	if activeMembers >= g.MaxMembers {
+		return ErrGroupFull
+	}
+	return nil
+}
+
+// ChangeMaxMembers ajusta o limite; não dá pra baixar abaixo de quem já está no grupo.
+func (g *Group) ChangeMaxMembers(maxMembers int, activeMembers int) error {
+	if maxMembers < activeMembers {
+		return ErrMaxMembersTooLow
+	}
+	g.MaxMembers = maxMembers
+	return nil
+}
+
+func (g *Group) IsArchived() bool {
+	return g.ArchivedAt != nil
+}
+
+// EnsureNotArchived barra qualquer alteração num grupo arquivado.
+func (g *Group) EnsureNotArchived() error {
+	if g.IsArchived() {
+		return ErrGroupArchived
+	}
+	return nil
+}
+
+// Archive congela o grupo; arquivar de novo é erro.
+func (g *Group) Archive(now time.Time) error {
+	if err := g.EnsureNotArchived(); err != nil {
+		return err
+	}
+	g.ArchivedAt = &now
+	g.UpdatedAt = now
+	return nil
+}
+
+func (g *Group) IsOwner(userID string) bool {
+	return g.CreatedByUserID == userID
+}
+
+// TransferOwnership passa o grupo pra outro membro; quem recebe precisa ser promovido a ADMIN.
+func (g *Group) TransferOwnership(toUserID string, now time.Time) {
+	g.CreatedByUserID = toUserID
+	g.UpdatedAt = now
+}
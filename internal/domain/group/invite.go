@@ -0,0 +1,91 @@
+package group
+
+import (
+	"crypto/rand"
+	"strings"
+	"time"
+)
+
+const (
+	inviteCodeLength = 8
+	// sem 0/O e 1/I/L pra facilitar ditar o código
+	inviteCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+	DefaultInviteTTL = 72 * time.Hour
+	MaxInviteTTL     = 30 * 24 * time.Hour
+)
+
+type InviteCode string
+
+func NewInviteCode(v string) (InviteCode, error) {
+	v = strings.ToUpper(strings.TrimSpace(v))
+	if len(v) != inviteCodeLength {
+		return "", ErrInvalidInviteCode
+	}
+	for _, c := range v {
+		if !strings.ContainsRune(inviteCodeAlphabet, c) {
+			return "", ErrInvalidInviteCode
+		}
+	}
+	return InviteCode(v), nil
+}
+
+// GenerateInviteCode sorteia um código curto usando crypto/rand.
+func GenerateInviteCode() (InviteCode, error) {
+	buf := make([]byte, inviteCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	out := make([]byte, inviteCodeLength)
+	for i, b := range buf {
+		out[i] = inviteCodeAlphabet[int(b)%len(inviteCodeAlphabet)]
+	}
+	return InviteCode(out), nil
+}
+
+type Invite struct {
+	ID              string
+	GroupID         string
+	Code            InviteCode
+	CreatedByUserID string
+	ExpiresAt       time.Time
+	SingleUse       bool
+	Uses            int
+	CreatedAt       time.Time
+}
+
+func NewInvite(
+	id string,
+	groupID string,
+	code InviteCode,
+	createdByUserID string,
+	ttl time.Duration,
+	singleUse bool,
+	createdAt time.Time,
+) (*Invite, error) {
+	if ttl <= 0 || ttl > MaxInviteTTL {
+		return nil, ErrInvalidInviteTTL
+	}
+
+	return &Invite{
+		ID:              id,
+		GroupID:         groupID,
+		Code:            code,
+		CreatedByUserID: createdByUserID,
+		ExpiresAt:       createdAt.Add(ttl),
+		SingleUse:       singleUse,
+		CreatedAt:       createdAt,
+	}, nil
+}
+
+// CheckUsable valida se o convite ainda pode ser aceito.
+func (i *Invite) CheckUsable(now time.Time) error {
+	if !now.Before(i.ExpiresAt) {
+		return ErrInviteExpired
+	}
+	if i.SingleUse && i.Uses > 0 {
+		return ErrInviteAlreadyUsed
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package group
+
+import "time"
+
+type JoinRequestStatus string
+
+const (
+	JoinRequestPending  JoinRequestStatus = "PENDING"
+	JoinRequestApproved JoinRequestStatus = "APPROVED"
+	JoinRequestRejected JoinRequestStatus = "REJECTED"
+)
+
+func (s JoinRequestStatus) String() string {
+	return string(s)
+}
+
+// JoinRequest é o pedido de um usuário pra entrar num grupo público.
+type JoinRequest struct {
+	ID              string
+	GroupID         string
+	UserID          string
+	Status          JoinRequestStatus
+	CreatedAt       time.Time
+	DecidedAt       *time.Time
+	DecidedByUserID *string
+}
+
+func NewJoinRequest(id string, groupID string, userID string, createdAt time.Time) *JoinRequest {
+	return &JoinRequest{
+		ID:        id,
+		GroupID:   groupID,
+		UserID:    userID,
+		Status:    JoinRequestPending,
+		CreatedAt: createdAt,
+	}
+}
+
+func (r *JoinRequest) Approve(byUserID string, now time.Time) error {
+	return r.decide(JoinRequestApproved, byUserID, now)
+}
+
+func (r *JoinRequest) Reject(byUserID string, now time.Time) error {
+	return r.decide(JoinRequestRejected, byUserID, now)
+}
+
+// decide só vale pra pedido pendente; decisão tomada não volta atrás.
+func (r *JoinRequest) decide(to JoinRequestStatus, byUserID string, now time.Time) error {
+	if r.Status != JoinRequestPending {
+		return ErrJoinRequestNotPending
+	}
+	r.Status = to
+	r.DecidedAt = &now
+	r.DecidedByUserID = &byUserID
+	return nil
+}
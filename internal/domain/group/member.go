@@ -0,0 +1,31 @@
+package group
+
+import "time"
+
+type Member struct {
+	GroupID  string
+	UserID   string
+	Role     Role
+	JoinedAt time.Time
+	LeftAt   *time.Time
+	IsActive bool
+}
+
+// ChangeRole troca o papel do membro garantindo as invariantes do grupo:
+// o dono (created_by_user_id) é sempre ADMIN e o grupo nunca fica sem ADMIN ativo.
+func (m *Member) ChangeRole(to Role, g *Group, activeAdmins int) error {
+	if m.Role == to {
+		return nil
+	}
+	if !to.IsAdmin() {
+		if g.IsOwner(m.UserID) {
+			return ErrOwnerMustBeAdmin
+		}
+		if m.Role.IsAdmin() && activeAdmins <= 1 {
+			return ErrLastAdmin
+		}
+	}
+
+	m.Role = to
+	return nil
+}
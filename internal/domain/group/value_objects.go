@@ -22,6 +22,19 @@ func NewIconID(v string) (IconID, error) {
 	return IconID(v), nil
 }
 
+const (
+	DefaultMaxMembers = 5
+	MaxMembersLimit   = 50
+)
+
+// NewMaxMembers valida o limite de membros configurável pelo admin.
+func NewMaxMembers(v int) (int, error) {
+	if v < 1 || v > MaxMembersLimit {
+		return 0, ErrInvalidMaxMembers
+	}
+	return v, nil
+}
+
 type Visibility string
 
 const (
@@ -30,6 +43,43 @@ const (
 	VisibilityFounders   Visibility = "FOUNDERS"
 )
 
+// NewVisibility valida a visibilidade escolhida pelo usuário; FOUNDERS é reservada e não entra aqui.
+func NewVisibility(v string) (Visibility, error) {
+	switch vis := Visibility(strings.ToUpper(strings.TrimSpace(v))); vis {
+	case VisibilityInviteOnly, VisibilityPublic:
+		return vis, nil
+	}
+	return "", ErrInvalidVisibility
+}
+
 func (v Visibility) String() string {
 	return string(v)
 }
+
+// IsPublic indica se o grupo aparece na busca e aceita pedidos de entrada.
+func (v Visibility) IsPublic() bool {
+	return v == VisibilityPublic
+}
+
+type Role string
+
+const (
+	RoleAdmin  Role = "ADMIN"
+	RoleMember Role = "MEMBER"
+)
+
+func NewRole(v string) (Role, error) {
+	switch r := Role(strings.ToUpper(strings.TrimSpace(v))); r {
+	case RoleAdmin, RoleMember:
+		return r, nil
+	}
+	return "", ErrInvalidRole
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) IsAdmin() bool {
+	return r == RoleAdmin
+}
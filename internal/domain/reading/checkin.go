@@ -0,0 +1,28 @@
+package reading
+
+import "time"
+
+// Checkin é um registro de leitura já gravado, do jeito que é repassado pros grupos do usuário.
+type Checkin struct {
+	UserID        string
+	UserCheckinID string
+	// Date é o dia pessoal (fuso do usuário, com a hora de tolerância); At é o instante do registro.
+	Date LocalDate
+	// FirstLoggedAt é o primeiro registro do dia pessoal: é por ele que o dia vira dia da season.
+	FirstLoggedAt time.Time
+	At            time.Time
+	Pages         int // total do dia depois do registro
+	Minutes       int // idem, em minutos
+	StreakDays    StreakDays
+	// Goal é a meta do usuário em vigor no dia (regra "bater a meta" das seasons).
+	Goal DailyGoal
+	// NewDay indica o primeiro registro do dia; registros seguintes só somam páginas/minutos.
+	NewDay bool
+}
+
+// streakMilestones são os marcos de sequência que aparecem no feed dos grupos.
+var streakMilestones = map[StreakDays]bool{7: true, 30: true, 100: true, 365: true}
+
+func (s StreakDays) IsMilestone() bool {
+	return streakMilestones[s]
+}
@@ -0,0 +1,56 @@
+package reading
+
+import (
+	"strings"
+	"time"
+)
+
+// DayBoundary é a regra de virada de dia num fuso IANA. Tudo que precisa de "dia local"
+// (registro de leitura, check-in na season, início/fim de season, "hoje" do ranking) passa
+// por aqui, pra não ter conversão de fuso espalhada.
+type DayBoundary struct {
+	loc *time.Location
+}
+
+// UTCDays é o fallback pra fusos gravados antes da validação.
+var UTCDays = DayBoundary{loc: time.UTC}
+
+// NewDayBoundary só aceita nomes que o time.LoadLocation conhece ("Mars/Olympus" não passa).
+// "Local" e vazio também são recusados: dependeriam da máquina onde o Lambda roda.
+func NewDayBoundary(tz string) (DayBoundary, error) {
+	tz = strings.TrimSpace(tz)
+	if tz == "" || tz == "Local" {
+		return DayBoundary{}, ErrInvalidTimezone
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return DayBoundary{}, ErrInvalidTimezone
+	}
+	return DayBoundary{loc: loc}, nil
+}
+
+func (b DayBoundary) Location() *time.Location {
+	if b.loc == nil {
+		return time.UTC
+	}
+	return b.loc
+}
+
+// In converte o instante pro fuso.
+func (b DayBoundary) In(t time.Time) time.Time {
+	return t.In(b.Location())
+}
+
+// DateOf é o dia local em que o instante cai.
+func (b DayBoundary) DateOf(t time.Time) LocalDate {
+	return LocalDate(b.In(t).Format("2006-01-02"))
+}
+
+// StartOf é o instante em que o dia local começa (meia-noite no fuso).
+func (b DayBoundary) StartOf(d LocalDate) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02", string(d), b.Location())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t, nil
+}
@@ -0,0 +1,27 @@
+package reading
+
+import "time"
+
+// DefaultEditWindow vale pros dias que não contam em nenhuma season e é o padrão de season nova.
+const DefaultEditWindow = 15 * time.Minute
+
+// MaxEditWindow: a janela não passa de um dia.
+const MaxEditWindow = 24 * time.Hour
+
+// EnsureEditable: um dia registrado em loggedAt pode ser corrigido ou apagado até loggedAt+window.
+// Janela zero trava o registro na hora.
+func EnsureEditable(loggedAt time.Time, now time.Time, window time.Duration) error {
+	if !now.Before(loggedAt.Add(window)) {
+		return ErrCheckinLocked
+	}
+	return nil
+}
+
+// ParseLocalDate valida um dia "YYYY-MM-DD" vindo de fora (ex: path param).
+func ParseLocalDate(v string) (LocalDate, error) {
+	t, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		return "", ErrInvalidDate
+	}
+	return LocalDate(t.Format("2006-01-02")), nil
+}
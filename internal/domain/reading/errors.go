@@ -2,4 +2,16 @@ package reading
 
 import "errors"
 
-var ErrInvalidPages = errors.New("invalid pages")
+var (
+	ErrInvalidPages = errors.New("invalid pages")
+	// ErrEmptyReading: um registro (ou meta) precisa de páginas, minutos ou os dois.
+	ErrEmptyReading = errors.New("pages or minutes is required")
+	// ErrInvalidTimezone: o fuso precisa ser um nome IANA válido (ex: America/Sao_Paulo).
+	ErrInvalidTimezone = errors.New("invalid timezone")
+	ErrInvalidDate     = errors.New("invalid date: expected YYYY-MM-DD")
+	ErrInvalidSource   = errors.New("invalid source: up to 32 characters among a-z, 0-9, '.', '_' and '-'")
+	// ErrCheckinLocked: a janela de correção do dia já fechou (em alguma season ou no padrão pessoal).
+	ErrCheckinLocked = errors.New("check-in can no longer be edited")
+	// ErrDayTotalOutOfRange: os totais do dia passariam de MaxDayPages/MaxDayMinutes.
+	ErrDayTotalOutOfRange = errors.New("day total out of range")
+)
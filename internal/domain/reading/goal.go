@@ -0,0 +1,33 @@
+package reading
+
+// DailyGoal é a meta diária do usuário. Páginas e minutos são independentes:
+// 0 numa das partes quer dizer "sem meta nessa unidade" (ex: quem só escuta audiobook).
+type DailyGoal struct {
+	Pages   int
+	Minutes int
+}
+
+// DefaultDailyGoal vale enquanto o usuário não escolheu uma meta.
+var DefaultDailyGoal = DailyGoal{Pages: 5}
+
+// MetBy: a meta é batida quando cada parte definida é atingida.
+func (g DailyGoal) MetBy(pages, minutes int) bool {
+	return pages >= g.Pages && minutes >= g.Minutes
+}
+
+func NewDailyGoal(pages, minutes int) (DailyGoal, error) {
+	if pages == 0 && minutes == 0 {
+		return DailyGoal{}, ErrEmptyReading
+	}
+	if pages != 0 {
+		if _, err := NewPages(pages); err != nil {
+			return DailyGoal{}, err
+		}
+	}
+	if minutes != 0 {
+		if _, err := NewMinutes(minutes); err != nil {
+			return DailyGoal{}, err
+		}
+	}
+	return DailyGoal{Pages: pages, Minutes: minutes}, nil
+}
@@ -0,0 +1,51 @@
+package reading
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Source identifica de onde veio o registro (ex: "ios", "web", "widget").
+type Source string
+
+const (
+	// DefaultSource vale quando o cliente não informa a origem.
+	DefaultSource Source = "unknown"
+	// SourceCorrection marca o ajuste gravado por PUT /v1/reading/logs/{date}: carrega a
+	// diferença (pode ser negativa) pra soma dos registros bater com o total do dia.
+	SourceCorrection Source = "correction"
+	// SourceLegacy são os totais de dias registrados antes de existir o histórico por registro.
+	SourceLegacy Source = "legacy"
+)
+
+var sourcePattern = regexp.MustCompile(`^[a-z0-9._-]{1,32}$`)
+
+// NewSource valida a origem enviada pelo cliente; as origens internas são reservadas.
+func NewSource(v string) (Source, error) {
+	v = strings.ToLower(strings.TrimSpace(v))
+	if v == "" {
+		return DefaultSource, nil
+	}
+	s := Source(v)
+	if !sourcePattern.MatchString(v) || s == SourceCorrection || s == SourceLegacy {
+		return "", ErrInvalidSource
+	}
+	return s, nil
+}
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// LogEntry é um registro individual de leitura; a soma dos registros do dia é o total
+// em user_checkins.
+type LogEntry struct {
+	ID            string
+	UserCheckinID string
+	Date          LocalDate
+	Pages         int
+	Minutes       int
+	Source        Source
+	LoggedAt      time.Time
+}
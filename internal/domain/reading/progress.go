@@ -7,9 +7,11 @@ type ReadingProgress struct {
 }
 
 type DayProgress struct {
-	Date      string `json:"date"`
-	Pages     int    `json:"pages"`
-	GoalPages int    `json:"goal_pages"`
+	Date        string `json:"date"`
+	Pages       int    `json:"pages"`
+	Minutes     int    `json:"minutes"`
+	GoalPages   int    `json:"goal_pages"`
+	GoalMinutes int    `json:"goal_minutes"`
 }
 
 type StreakProgress struct {
@@ -19,5 +21,6 @@ type StreakProgress struct {
 type WeekDayProgress struct {
 	Date    string `json:"date"`
 	Pages   int    `json:"pages"`
+	Minutes int    `json:"minutes"`
 	Checked bool   `json:"checked"`
 }
@@ -7,6 +7,7 @@ import (
 
 type LocalDate string // "YYYY-MM-DD"
 type Pages int
+type Minutes int
 type TargetDatePolicy struct {
 	GraceHour int // 2
 }
@@ -20,8 +21,30 @@ func NewPages(v int) (Pages, error) {
 	return Pages(v), nil
 }
 
-func DateOf(t time.Time, loc *time.Location) LocalDate {
-	return LocalDate(t.In(loc).Format("2006-01-02"))
+// NewMinutes: minutos de leitura/escuta num registro; um dia tem no máximo 1440.
+func NewMinutes(v int) (Minutes, error) {
+	if v <= 0 || v > 1440 {
+		return 0, fmt.Errorf("minutes out of range")
+	}
+	return Minutes(v), nil
+}
+
+// Teto dos totais de um dia: soma de vários registros, então acima do limite de um
+// registro só; vale pro POST (depois de somar) e pra correção do dia.
+const (
+	MaxDayPages   = 2000
+	MaxDayMinutes = 1440
+)
+
+// NewDayTotals valida os totais de um dia (0 = unidade sem leitura, mas não as duas).
+func NewDayTotals(pages, minutes int) (Pages, Minutes, error) {
+	if pages == 0 && minutes == 0 {
+		return 0, 0, ErrEmptyReading
+	}
+	if pages < 0 || pages > MaxDayPages || minutes < 0 || minutes > MaxDayMinutes {
+		return 0, 0, ErrDayTotalOutOfRange
+	}
+	return Pages(pages), Minutes(minutes), nil
 }
 
 func (d LocalDate) AddDays(n int) LocalDate {
@@ -31,9 +54,16 @@ func (d LocalDate) AddDays(n int) LocalDate {
 
 func (d LocalDate) String() string { return string(d) }
 
-func (p TargetDatePolicy) Resolve(now time.Time, loc *time.Location, hasYesterday bool) LocalDate {
-	realDate := DateOf(now, loc)
-	if now.In(loc).Hour() < p.GraceHour {
+// DaysUntil conta os dias de calendário de d até o (negativo se o vem antes).
+func (d LocalDate) DaysUntil(o LocalDate) int {
+	from, _ := time.Parse("2006-01-02", string(d))
+	to, _ := time.Parse("2006-01-02", string(o))
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func (p TargetDatePolicy) Resolve(now time.Time, days DayBoundary, hasYesterday bool) LocalDate {
+	realDate := days.DateOf(now)
+	if days.In(now).Hour() < p.GraceHour {
 		if hasYesterday {
 			return realDate
 		}
@@ -0,0 +1,48 @@
+package season
+
+import (
+	"time"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
+
+// Checkin é a linha de group_checkins: o check-in pessoal contado numa season do grupo.
+type Checkin struct {
+	GroupID       string
+	SeasonID      string
+	UserID        string
+	UserCheckinID string
+	LocalDate     readingDomain.LocalDate
+}
+
+// Accepts diz se um registro feito em "at" conta pra season: ela precisa estar ACTIVE
+// e "at" dentro de [started_at, ends_at).
+func (s *Season) Accepts(at time.Time) bool {
+	if s.Status != StatusActive || s.StartedAt == nil || at.Before(*s.StartedAt) {
+		return false
+	}
+	return s.EndsAt == nil || at.Before(*s.EndsAt)
+}
+
+// CheckinFor monta o check-in da season. O dia é o dia pessoal, mas nunca depois do dia
+// (no fuso da season) do primeiro registro: numa season à frente do usuário o dia pessoal
+// vale como está (inclusive o retroativo da hora de tolerância); numa season atrás dele o
+// dia recua pro dia em que a leitura de fato aconteceu lá, em vez de cair no futuro.
+// Como depende só do dia e do primeiro registro, todos os registros do dia dão o mesmo resultado.
+func (s *Season) CheckinFor(c readingDomain.Checkin) (Checkin, error) {
+	days, err := s.Timezone.Days()
+	if err != nil {
+		return Checkin{}, err
+	}
+	date := c.Date
+	if first := days.DateOf(c.FirstLoggedAt); first.DaysUntil(date) > 0 {
+		date = first
+	}
+	return Checkin{
+		GroupID:       s.GroupID,
+		SeasonID:      s.ID,
+		UserID:        c.UserID,
+		UserCheckinID: c.UserCheckinID,
+		LocalDate:     date,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package season
+
+import (
+	"testing"
+	"time"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
+
+func TestCheckinForSeasonDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		userTZ   string
+		seasonTZ string
+		date     readingDomain.LocalDate
+		// primeiro registro do dia, no relógio do usuário
+		first time.Time
+		want  readingDomain.LocalDate
+	}{
+		{"mesmo fuso", "America/Sao_Paulo", "America/Sao_Paulo", "2026-03-10",
+			wall(2026, 3, 10, 19, 0), "2026-03-10"},
+		{"mesmo fuso, retroativo na hora de tolerância", "America/Sao_Paulo", "America/Sao_Paulo", "2026-03-10",
+			wall(2026, 3, 11, 1, 0), "2026-03-10"},
+		{"season à frente, antes da meia-noite dela", "America/Sao_Paulo", "Europe/Lisbon", "2026-03-10",
+			wall(2026, 3, 10, 19, 0), "2026-03-10"},
+		{"season à frente, depois da meia-noite dela", "America/Sao_Paulo", "Europe/Lisbon", "2026-03-10",
+			wall(2026, 3, 10, 22, 30), "2026-03-10"},
+		{"season à frente, retroativo na hora de tolerância", "America/Sao_Paulo", "Europe/Lisbon", "2026-03-10",
+			wall(2026, 3, 11, 1, 0), "2026-03-10"},
+		{"Tóquio, manhã em São Paulo", "America/Sao_Paulo", "Asia/Tokyo", "2026-03-10",
+			wall(2026, 3, 10, 10, 0), "2026-03-10"},
+		{"Tóquio, noite em São Paulo", "America/Sao_Paulo", "Asia/Tokyo", "2026-03-10",
+			wall(2026, 3, 10, 20, 0), "2026-03-10"},
+		{"season atrás, registro cedo recua pro dia de lá", "Asia/Tokyo", "America/Sao_Paulo", "2026-03-10",
+			wall(2026, 3, 10, 8, 0), "2026-03-09"},
+		{"season atrás, registro tarde fica no dia pessoal", "Asia/Tokyo", "America/Sao_Paulo", "2026-03-10",
+			wall(2026, 3, 10, 23, 0), "2026-03-10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userLoc, err := time.LoadLocation(tt.userTZ)
+			if err != nil {
+				t.Fatal(err)
+			}
+			first := time.Date(tt.first.Year(), tt.first.Month(), tt.first.Day(), tt.first.Hour(), tt.first.Minute(), 0, 0, userLoc)
+			s := &Season{ID: "season-1", GroupID: "group-1", Timezone: Timezone(tt.seasonTZ)}
+
+			got, err := s.CheckinFor(readingDomain.Checkin{
+				UserID:        "user-1",
+				UserCheckinID: "checkin-1",
+				Date:          tt.date,
+				FirstLoggedAt: first,
+				// registros seguintes do dia não mudam a data
+				At: first.Add(3 * time.Hour),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.LocalDate != tt.want {
+				t.Errorf("LocalDate = %s, want %s", got.LocalDate, tt.want)
+			}
+
+			// a data nunca fica no futuro da season no momento do primeiro registro
+			days, err := s.Timezone.Days()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if today := days.DateOf(first); got.LocalDate.DaysUntil(today) < 0 {
+				t.Errorf("LocalDate %s is after the season's today %s", got.LocalDate, today)
+			}
+		})
+	}
+}
+
+// wall é só o relógio (ano a minuto); o fuso vem do caso de teste.
+func wall(y int, m time.Month, d, hh, mm int) time.Time {
+	return time.Date(y, m, d, hh, mm, 0, 0, time.UTC)
+}
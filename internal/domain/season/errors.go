@@ -1,7 +1,35 @@
 package season
 
-import "errors"
+import (
+	"errors"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
 
 var (
-	ErrInvalidTimezone = errors.New("invalid timezone")
+	ErrInvalidTimezone   = readingDomain.ErrInvalidTimezone
+	ErrInvalidMetric     = errors.New("invalid metric: must be CHECKINS_PER_DAY, PAGES or MINUTES")
+	ErrInvalidStatus     = errors.New("invalid status: must be DRAFT, ACTIVE or ENDED")
+	ErrInvalidEditWindow = errors.New("invalid edit_window_minutes: must be between 0 and 1440")
+	// ErrInvalidQualification: min_pages vai até 500 e min_minutes até 1440 (0 = sem mínimo).
+	ErrInvalidQualification  = errors.New("invalid qualification: min_pages must be between 0 and 500 and min_minutes between 0 and 1440")
+	ErrInvalidLateJoinPolicy = errors.New("invalid late_join_policy: must be EXCLUDED, FROM_JOIN_DATE or PRORATED")
+	ErrInvalidPeriod         = errors.New("invalid period: must be WEEKLY, MONTHLY or DAYS with period_days between 1 and 366")
+
+	ErrSeasonNotDraft     = errors.New("only DRAFT seasons can be activated")
+	ErrSeasonNotActive    = errors.New("only ACTIVE seasons can be ended")
+	ErrSeasonNotEnded     = errors.New("season results are only available after it ends")
+	ErrEndsAtNotInFuture  = errors.New("ends_at must be in the future")
+	ErrActiveSeasonExists = errors.New("group already has an active season")
+	ErrCheckinLocked      = readingDomain.ErrCheckinLocked
+
+	ErrInvalidTeamCount        = errors.New("a team season needs between 2 and 10 teams")
+	ErrInvalidTeamName         = errors.New("team name must have between 1 and 40 characters")
+	ErrDuplicateTeamName       = errors.New("team names must be unique")
+	ErrEmptyTeam               = errors.New("every team needs at least one member")
+	ErrTeamMemberNotInGroup    = errors.New("team member is not an active member of the group")
+	ErrTeamMemberAssignedTwice = errors.New("member is assigned to more than one team")
+	ErrTeamMemberUnassigned    = errors.New("every active member must be on a team")
+	// ErrTeamsOutOfDate: o grupo mudou depois da divisão (alguém entrou ou saiu); refazer antes de ativar.
+	ErrTeamsOutOfDate = errors.New("teams no longer match the group's active members")
 )
@@ -0,0 +1,115 @@
+package season
+
+import (
+	"sort"
+	"time"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
+
+// ParticipantStats é o agregado de um participante do roster da season, vindo de group_checkins.
+type ParticipantStats struct {
+	UserID      string
+	CheckinDays int
+	// Pages soma pages_total de cada user_checkins contado na season (lido na hora, então
+	// páginas adicionadas depois do check-in no grupo entram).
+	Pages int
+	// Minutes é o mesmo agregado em minutes_total.
+	Minutes int
+	// LastCheckinDate é o último dia contado ("" se nunca fez check-in na season).
+	LastCheckinDate readingDomain.LocalDate
+	// LastRunDays é o tamanho da sequência de dias consecutivos que termina em LastCheckinDate.
+	LastRunDays int
+	// LongestStreak é a maior sequência de dias consecutivos na season.
+	LongestStreak int
+	// BestDayPages/BestDayMinutes são o maior user_checkins contado na season.
+	BestDayPages   int
+	BestDayMinutes int
+	// Late/JoinedAt vêm do roster (season_participants): Late = entrou depois da ativação.
+	Late     bool
+	JoinedAt time.Time
+}
+
+// Standing é a posição de um participante no ranking.
+type Standing struct {
+	ParticipantStats
+	Rank          int
+	Score         int
+	CurrentStreak int
+}
+
+// CurrentStreak só conta se a sequência ainda está viva: último check-in hoje ou ontem
+// (no fuso da season). today é o dia de referência, ver Season.ReferenceDate.
+func (p ParticipantStats) CurrentStreak(today readingDomain.LocalDate) int {
+	if p.LastCheckinDate == "" {
+		return 0
+	}
+	if p.LastCheckinDate == today || p.LastCheckinDate == today.AddDays(-1) {
+		return p.LastRunDays
+	}
+	return 0
+}
+
+// Rank ordena os participantes e atribui as posições.
+//
+// Desempate, nesta ordem:
+//  1. maior score;
+//  2. maior sequência atual;
+//  3. último check-in mais antigo (quem chegou primeiro naquele score fica na frente);
+//  4. user_id, só pra ordem ser estável.
+//
+// Quem empata nos critérios 1-3 divide a posição (ranking "1, 2, 2, 4").
+func (s *Season) Rank(stats []ParticipantStats, today readingDomain.LocalDate) []Standing {
+	out := make([]Standing, 0, len(stats))
+	for _, p := range stats {
+		out = append(out, Standing{
+			ParticipantStats: p,
+			Score:            s.prorate(s.Metric.Score(p), p, today),
+			CurrentStreak:    p.CurrentStreak(today),
+		})
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if c := compareStanding(a, b); c != 0 {
+			return c < 0
+		}
+		return a.UserID < b.UserID
+	})
+
+	for i := range out {
+		if i > 0 && compareStanding(out[i-1], out[i]) == 0 {
+			out[i].Rank = out[i-1].Rank
+			continue
+		}
+		out[i].Rank = i + 1
+	}
+	return out
+}
+
+// compareStanding devolve <0 se a fica na frente de b, >0 se atrás e 0 se empatam.
+func compareStanding(a, b Standing) int {
+	if a.Score != b.Score {
+		return b.Score - a.Score
+	}
+	if a.CurrentStreak != b.CurrentStreak {
+		return b.CurrentStreak - a.CurrentStreak
+	}
+	return compareLastCheckin(a.LastCheckinDate, b.LastCheckinDate)
+}
+
+// sem check-in vai pro fim
+func compareLastCheckin(a, b readingDomain.LocalDate) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	case a < b:
+		return -1
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,85 @@
+package season
+
+import "strings"
+
+// MetricStrategy calcula a pontuação de um participante a partir das estatísticas da season.
+// Cada Metric suportada tem a sua; métrica nova = estratégia nova registrada aqui.
+type MetricStrategy interface {
+	Score(p ParticipantStats) int
+	// BestDay é o maior volume num único dia, usado no destaque "maior dia" dos resultados.
+	BestDay(p ParticipantStats) BestDay
+}
+
+// BestDay traz o maior dia em cada unidade que a métrica considera (0 na que não conta).
+type BestDay struct {
+	Pages   int
+	Minutes int
+}
+
+// checkinsPerDay: 1 ponto por dia (local da season) com check-in.
+type checkinsPerDay struct{}
+
+func (checkinsPerDay) Score(p ParticipantStats) int {
+	return p.CheckinDays
+}
+
+// todo dia vale 1 check-in, então o "maior dia" vale nas duas unidades: quem só registra
+// minutos também disputa o destaque
+func (checkinsPerDay) BestDay(p ParticipantStats) BestDay {
+	return BestDay{Pages: p.BestDayPages, Minutes: p.BestDayMinutes}
+}
+
+// pagesRead: soma das páginas dos dias pessoais contados na season.
+type pagesRead struct{}
+
+func (pagesRead) Score(p ParticipantStats) int {
+	return p.Pages
+}
+
+func (pagesRead) BestDay(p ParticipantStats) BestDay {
+	return BestDay{Pages: p.BestDayPages}
+}
+
+// minutesRead: soma dos minutos (leitura ou audiobook), mesma regra de contagem de pagesRead.
+type minutesRead struct{}
+
+func (minutesRead) Score(p ParticipantStats) int {
+	return p.Minutes
+}
+
+func (minutesRead) BestDay(p ParticipantStats) BestDay {
+	return BestDay{Minutes: p.BestDayMinutes}
+}
+
+var metricStrategies = map[Metric]MetricStrategy{
+	MetricCheckinsPerDay: checkinsPerDay{},
+	MetricPages:          pagesRead{},
+	MetricMinutes:        minutesRead{},
+}
+
+// NewMetric valida a métrica escolhida; só as que têm estratégia podem ser usadas.
+func NewMetric(v string) (Metric, error) {
+	m := Metric(strings.ToUpper(strings.TrimSpace(v)))
+	if _, ok := metricStrategies[m]; !ok {
+		return "", ErrInvalidMetric
+	}
+	return m, nil
+}
+
+// Score é a pontuação do participante segundo a métrica da season.
+func (m Metric) Score(p ParticipantStats) int {
+	strategy, ok := metricStrategies[m]
+	if !ok {
+		return 0
+	}
+	return strategy.Score(p)
+}
+
+// BestDay é o maior dia do participante nas unidades da métrica.
+func (m Metric) BestDay(p ParticipantStats) BestDay {
+	strategy, ok := metricStrategies[m]
+	if !ok {
+		return BestDay{}
+	}
+	return strategy.BestDay(p)
+}
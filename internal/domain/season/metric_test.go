@@ -0,0 +1,70 @@
+package season
+
+import (
+	"testing"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
+
+func TestMetricScore(t *testing.T) {
+	// dois dias pessoais contados no mesmo dia da season: um dia, páginas e minutos dos dois
+	p := ParticipantStats{UserID: "u1", CheckinDays: 1, Pages: 17, Minutes: 35, BestDayPages: 10, BestDayMinutes: 20}
+
+	tests := []struct {
+		metric  Metric
+		score   int
+		bestDay BestDay
+	}{
+		{MetricCheckinsPerDay, 1, BestDay{Pages: 10, Minutes: 20}},
+		{MetricPages, 17, BestDay{Pages: 10}},
+		{MetricMinutes, 35, BestDay{Minutes: 20}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.metric), func(t *testing.T) {
+			if got := tt.metric.Score(p); got != tt.score {
+				t.Errorf("Score = %d, want %d", got, tt.score)
+			}
+			if got := tt.metric.BestDay(p); got != tt.bestDay {
+				t.Errorf("BestDay = %+v, want %+v", got, tt.bestDay)
+			}
+		})
+	}
+
+	if got := Metric("UNKNOWN").Score(p); got != 0 {
+		t.Errorf("unknown metric Score = %d, want 0", got)
+	}
+}
+
+func TestRankByMetric(t *testing.T) {
+	today := readingDomain.LocalDate("2026-03-12")
+	stats := []ParticipantStats{
+		// poucos dias, muitas páginas
+		{UserID: "pages", CheckinDays: 1, Pages: 40, Minutes: 10, LastCheckinDate: "2026-03-12", LastRunDays: 1},
+		// muitos dias, pouca leitura por dia
+		{UserID: "days", CheckinDays: 3, Pages: 9, Minutes: 15, LastCheckinDate: "2026-03-12", LastRunDays: 3},
+		// só audiobook
+		{UserID: "minutes", CheckinDays: 2, Pages: 0, Minutes: 90, LastCheckinDate: "2026-03-11", LastRunDays: 2},
+	}
+
+	tests := []struct {
+		metric Metric
+		order  []string
+		scores []int
+	}{
+		{MetricCheckinsPerDay, []string{"days", "minutes", "pages"}, []int{3, 2, 1}},
+		{MetricPages, []string{"pages", "days", "minutes"}, []int{40, 9, 0}},
+		{MetricMinutes, []string{"minutes", "days", "pages"}, []int{90, 15, 10}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.metric), func(t *testing.T) {
+			s := &Season{Metric: tt.metric, LateJoinPolicy: LateJoinFromJoinDate}
+			got := s.Rank(stats, today)
+			for i, st := range got {
+				if st.UserID != tt.order[i] || st.Score != tt.scores[i] || st.Rank != i+1 {
+					t.Fatalf("position %d = %s (score %d, rank %d), want %s (score %d, rank %d)",
+						i, st.UserID, st.Score, st.Rank, tt.order[i], tt.scores[i], i+1)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package season
+
+import (
+	"strings"
+	"time"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
+
+// LateJoinPolicy diz o que acontece com quem entra no grupo com a season já rodando.
+type LateJoinPolicy string
+
+const (
+	// LateJoinExcluded: só quem estava no grupo na ativação participa.
+	LateJoinExcluded LateJoinPolicy = "EXCLUDED"
+	// LateJoinFromJoinDate: entra no roster e pontua a partir do dia em que entrou.
+	LateJoinFromJoinDate LateJoinPolicy = "FROM_JOIN_DATE"
+	// LateJoinProrated: como FROM_JOIN_DATE, mas o score é projetado pra season inteira
+	// (score * dias da season / dias desde a entrada).
+	LateJoinProrated LateJoinPolicy = "PRORATED"
+)
+
+func (p LateJoinPolicy) String() string {
+	return string(p)
+}
+
+func NewLateJoinPolicy(v string) (LateJoinPolicy, error) {
+	p := LateJoinPolicy(strings.ToUpper(strings.TrimSpace(v)))
+	switch p {
+	case LateJoinExcluded, LateJoinFromJoinDate, LateJoinProrated:
+		return p, nil
+	}
+	return "", ErrInvalidLateJoinPolicy
+}
+
+// Participant é uma linha de season_participants: o roster congelado na ativação
+// mais quem entrou depois (Late). Sair do grupo não tira ninguém do roster.
+type Participant struct {
+	SeasonID string
+	UserID   string
+	JoinedAt time.Time
+	Late     bool
+}
+
+// ChangeLateJoinPolicy só antes de começar, como as outras regras da season.
+func (s *Season) ChangeLateJoinPolicy(p LateJoinPolicy) error {
+	if s.Status != StatusDraft {
+		return ErrSeasonNotDraft
+	}
+	s.LateJoinPolicy = p
+	return nil
+}
+
+// LateParticipant decide se quem entrou no grupo em "now" passa a participar da season.
+func (s *Season) LateParticipant(userID string, now time.Time) (Participant, bool) {
+	if s.Status != StatusActive || s.LateJoinPolicy == LateJoinExcluded {
+		return Participant{}, false
+	}
+	return Participant{SeasonID: s.ID, UserID: userID, JoinedAt: now, Late: true}, true
+}
+
+// prorate projeta o score de quem entrou atrasado pra duração da season até "today".
+// Sem fuso válido ou sem início, fica o score cru.
+func (s *Season) prorate(score int, p ParticipantStats, today readingDomain.LocalDate) int {
+	if s.LateJoinPolicy != LateJoinProrated || !p.Late || s.StartedAt == nil || score == 0 {
+		return score
+	}
+	days, err := s.Timezone.Days()
+	if err != nil {
+		return score
+	}
+
+	total := days.DateOf(*s.StartedAt).DaysUntil(today) + 1
+	present := days.DateOf(p.JoinedAt).DaysUntil(today) + 1
+	if present <= 0 || present >= total {
+		return score
+	}
+	return (score*total + present/2) / present
+}
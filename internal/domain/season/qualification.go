@@ -0,0 +1,51 @@
+package season
+
+import (
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
+
+// Qualification são as regras pra um dia contar na season. Os mínimos valem sobre o
+// total do dia (não sobre cada registro), então o dia pode passar a contar mais tarde,
+// quando um novo registro leva o total acima do mínimo.
+// Zero value = qualquer leitura conta.
+type Qualification struct {
+	MinPages    int  // 0 = sem mínimo de páginas
+	MinMinutes  int  // 0 = sem mínimo de minutos
+	RequireGoal bool // o dia só conta se bater a meta pessoal do membro
+}
+
+func NewQualification(minPages, minMinutes int, requireGoal bool) (Qualification, error) {
+	if minPages != 0 {
+		if _, err := readingDomain.NewPages(minPages); err != nil {
+			return Qualification{}, ErrInvalidQualification
+		}
+	}
+	if minMinutes != 0 {
+		if _, err := readingDomain.NewMinutes(minMinutes); err != nil {
+			return Qualification{}, ErrInvalidQualification
+		}
+	}
+	return Qualification{MinPages: minPages, MinMinutes: minMinutes, RequireGoal: requireGoal}, nil
+}
+
+// Qualifies avalia o total do dia já gravado. Cada mínimo configurado precisa ser atingido.
+func (q Qualification) Qualifies(c readingDomain.Checkin) bool {
+	if c.Pages < q.MinPages || c.Minutes < q.MinMinutes {
+		return false
+	}
+	return !q.RequireGoal || c.Goal.MetBy(c.Pages, c.Minutes)
+}
+
+// ChangeQualification, como a janela de correção, só muda antes da season começar.
+func (s *Season) ChangeQualification(q Qualification) error {
+	if s.Status != StatusDraft {
+		return ErrSeasonNotDraft
+	}
+	s.Qualification = q
+	return nil
+}
+
+// Counts diz se o check-in entra na season: registro dentro do período e dia qualificado.
+func (s *Season) Counts(c readingDomain.Checkin) bool {
+	return s.Accepts(c.At) && s.Qualification.Qualifies(c)
+}
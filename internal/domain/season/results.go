@@ -0,0 +1,92 @@
+package season
+
+import (
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
+
+// Result é a linha congelada de um participante quando a season termina.
+// O perfil também é copiado: sair do grupo ou apagar a conta depois não muda o resultado.
+type Result struct {
+	UserID          string
+	DisplayName     string
+	AvatarURL       string
+	Rank            int
+	Score           int
+	FinalStreak     int // sequência viva no último dia da season
+	LongestStreak   int
+	CheckinDays     int
+	BestDay         BestDay // maior dia nas unidades da métrica, ver MetricStrategy.BestDay
+	LastCheckinDate readingDomain.LocalDate
+}
+
+type Highlight string
+
+const (
+	// HighlightMostConsistent: mais dias com check-in (desempate pela maior sequência).
+	HighlightMostConsistent Highlight = "MOST_CONSISTENT"
+	// HighlightBiggestDay: maior volume num único dia, em páginas ou em minutos.
+	HighlightBiggestDay Highlight = "BIGGEST_DAY"
+)
+
+// PodiumSize: posições que aparecem no pódio.
+const PodiumSize = 3
+
+// Results congela o ranking final. today é o último dia da season (ver ReferenceDate).
+// Os perfis vêm de fora porque ParticipantStats só carrega números.
+func (s *Season) Results(stats []ParticipantStats, today readingDomain.LocalDate, profile func(userID string) (string, string)) []Result {
+	standings := s.Rank(stats, today)
+	out := make([]Result, 0, len(standings))
+	for _, st := range standings {
+		name, avatar := profile(st.UserID)
+		out = append(out, Result{
+			UserID:          st.UserID,
+			DisplayName:     name,
+			AvatarURL:       avatar,
+			Rank:            st.Rank,
+			Score:           st.Score,
+			FinalStreak:     st.CurrentStreak,
+			LongestStreak:   st.LongestStreak,
+			CheckinDays:     st.CheckinDays,
+			BestDay:         s.Metric.BestDay(st.ParticipantStats),
+			LastCheckinDate: st.LastCheckinDate,
+		})
+	}
+	return out
+}
+
+// OnPodium: top 3 com pontuação; quem não pontuou não sobe no pódio mesmo empatado.
+func (r Result) OnPodium() bool {
+	return r.Score > 0 && r.Rank <= PodiumSize
+}
+
+func (r Result) IsWinner() bool {
+	return r.Score > 0 && r.Rank == 1
+}
+
+// Highlights devolve os destaques de cada participante (por user_id).
+// Empates levam todos; ninguém ganha destaque com valor zero.
+func Highlights(results []Result) map[string][]Highlight {
+	out := map[string][]Highlight{}
+
+	var bestDays, bestStreak int
+	var bestDay BestDay
+	for _, r := range results {
+		if r.CheckinDays > bestDays || (r.CheckinDays == bestDays && r.LongestStreak > bestStreak) {
+			bestDays, bestStreak = r.CheckinDays, r.LongestStreak
+		}
+		bestDay.Pages = max(bestDay.Pages, r.BestDay.Pages)
+		bestDay.Minutes = max(bestDay.Minutes, r.BestDay.Minutes)
+	}
+
+	for _, r := range results {
+		if bestDays > 0 && r.CheckinDays == bestDays && r.LongestStreak == bestStreak {
+			out[r.UserID] = append(out[r.UserID], HighlightMostConsistent)
+		}
+		// páginas e minutos não se comparam: cada unidade tem o seu maior dia
+		if (bestDay.Pages > 0 && r.BestDay.Pages == bestDay.Pages) ||
+			(bestDay.Minutes > 0 && r.BestDay.Minutes == bestDay.Minutes) {
+			out[r.UserID] = append(out[r.UserID], HighlightBiggestDay)
+		}
+	}
+	return out
+}
@@ -0,0 +1,23 @@
+package season
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestHighlightsBiggestDayPerUnit(t *testing.T) {
+	results := []Result{
+		{UserID: "pages", CheckinDays: 1, BestDay: MetricCheckinsPerDay.BestDay(ParticipantStats{BestDayPages: 40})},
+		{UserID: "minutes", CheckinDays: 1, BestDay: MetricCheckinsPerDay.BestDay(ParticipantStats{BestDayMinutes: 90})},
+		{UserID: "both", CheckinDays: 1, BestDay: MetricCheckinsPerDay.BestDay(ParticipantStats{BestDayPages: 12, BestDayMinutes: 30})},
+	}
+
+	highlights := Highlights(results)
+	for _, r := range results {
+		got := slices.Contains(highlights[r.UserID], HighlightBiggestDay)
+		want := r.UserID != "both"
+		if got != want {
+			t.Errorf("%s: BIGGEST_DAY = %v, want %v", r.UserID, got, want)
+		}
+	}
+}
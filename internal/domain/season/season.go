@@ -1,15 +1,25 @@
 package season
 
-import "time"
+import (
+	"time"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
 
 type Season struct {
-	ID              string
-	GroupID         string
-	Status          Status
-	StartedAt       *time.Time
-	EndsAt          *time.Time
-	Timezone        Timezone
-	Metric          Metric
+	ID        string
+	GroupID   string
+	Status    Status
+	StartedAt *time.Time
+	EndsAt    *time.Time
+	Timezone  Timezone
+	Metric    Metric
+	// EditWindow é por quanto tempo depois do registro o membro ainda pode corrigir o dia.
+	EditWindow time.Duration
+	// Qualification decide se o dia de leitura conta (mínimos e meta pessoal).
+	Qualification Qualification
+	// LateJoinPolicy vale pra quem entra no grupo com a season ACTIVE.
+	LateJoinPolicy  LateJoinPolicy
 	CreatedByUserID string
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
@@ -34,8 +44,103 @@ func New(
 		EndsAt:          endsAt,
 		Timezone:        timezone,
 		Metric:          metric,
+		EditWindow:      readingDomain.DefaultEditWindow,
+		LateJoinPolicy:  LateJoinFromJoinDate,
 		CreatedByUserID: createdByUserID,
 		CreatedAt:       createdAt,
 		UpdatedAt:       createdAt,
 	}
 }
+
+// ChangeEditWindow só é permitido antes da season começar: mudar a regra no meio
+// destravaria (ou travaria) check-ins já feitos.
+func (s *Season) ChangeEditWindow(minutes int) error {
+	window, err := NewEditWindow(minutes)
+	if err != nil {
+		return err
+	}
+	if s.Status != StatusDraft {
+		return ErrSeasonNotDraft
+	}
+	s.EditWindow = window
+	return nil
+}
+
+// EnsureCheckinEditable aplica a janela da season a um dia que ela contou.
+// Season encerrada trava tudo: o resultado já foi congelado.
+func (s *Season) EnsureCheckinEditable(loggedAt time.Time, now time.Time) error {
+	if s.Status != StatusActive {
+		return ErrCheckinLocked
+	}
+	return readingDomain.EnsureEditable(loggedAt, now, s.EditWindow)
+}
+
+// Activate inicia a season: DRAFT → ACTIVE, com started_at = now.
+// Se houver ends_at, ele precisa estar no futuro.
+func (s *Season) Activate(now time.Time) error {
+	if s.Status != StatusDraft {
+		return ErrSeasonNotDraft
+	}
+	if s.EndsAt != nil && !s.EndsAt.After(now) {
+		return ErrEndsAtNotInFuture
+	}
+	s.Status = StatusActive
+	s.StartedAt = &now
+	s.UpdatedAt = now
+	return nil
+}
+
+// End encerra a season: ACTIVE → ENDED. ends_at passa a ser o momento real do fim
+// quando estava vazio ou ainda no futuro.
+func (s *Season) End(now time.Time) error {
+	if s.Status != StatusActive {
+		return ErrSeasonNotActive
+	}
+	if s.EndsAt == nil || s.EndsAt.After(now) {
+		s.EndsAt = &now
+	}
+	s.Status = StatusEnded
+	s.UpdatedAt = now
+	return nil
+}
+
+// HasExpired diz se uma season ACTIVE já passou do ends_at e deve ser encerrada.
+func (s *Season) HasExpired(now time.Time) bool {
+	return s.Status == StatusActive && s.EndsAt != nil && !now.Before(*s.EndsAt)
+}
+
+// LocalStartDate é o dia (no fuso da season) em que ela começou; "" antes de ativar.
+func (s *Season) LocalStartDate() (string, error) {
+	if s.StartedAt == nil {
+		return "", nil
+	}
+	days, err := s.Timezone.Days()
+	if err != nil {
+		return "", err
+	}
+	return days.DateOf(*s.StartedAt).String(), nil
+}
+
+// LocalEndDate é o dia (no fuso da season) em que ela terminou ou vai terminar; "" sem ends_at.
+func (s *Season) LocalEndDate() (string, error) {
+	if s.EndsAt == nil {
+		return "", nil
+	}
+	days, err := s.Timezone.Days()
+	if err != nil {
+		return "", err
+	}
+	return days.DateOf(*s.EndsAt).String(), nil
+}
+
+// ReferenceDate é o "hoje" da season no fuso dela; depois do fim, congela no último dia.
+func (s *Season) ReferenceDate(now time.Time) (readingDomain.LocalDate, error) {
+	days, err := s.Timezone.Days()
+	if err != nil {
+		return "", err
+	}
+	if s.EndsAt != nil && now.After(*s.EndsAt) {
+		now = *s.EndsAt
+	}
+	return days.DateOf(now), nil
+}
@@ -0,0 +1,150 @@
+package season
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Uma season de times tem de 2 a MaxTeams times.
+const (
+	MinTeams       = 2
+	MaxTeams       = 10
+	MaxTeamNameLen = 40
+)
+
+// Team é um time da season; MemberIDs são user_ids de membros ativos do grupo.
+type Team struct {
+	ID        string
+	SeasonID  string
+	Name      string
+	MemberIDs []string
+	CreatedAt time.Time
+}
+
+// TeamStanding é a posição de um time: Score soma o score dos membros na métrica da season.
+type TeamStanding struct {
+	Team
+	Rank    int
+	Score   int
+	Members []Standing
+}
+
+func NewTeamName(v string) (string, error) {
+	v = strings.TrimSpace(v)
+	if v == "" || utf8.RuneCountInString(v) > MaxTeamNameLen {
+		return "", ErrInvalidTeamName
+	}
+	return v, nil
+}
+
+// AssignTeams troca os times da season. Só antes de começar: no meio da season
+// mudaria de lado pontos já feitos.
+func (s *Season) AssignTeams(teams []Team, activeMemberIDs []string) error {
+	if s.Status != StatusDraft {
+		return ErrSeasonNotDraft
+	}
+	return ValidateTeams(teams, activeMemberIDs)
+}
+
+// ValidateTeams garante que todo membro ativo está em exatamente um time, que nenhum
+// time está vazio e que não há time com gente de fora do grupo.
+func ValidateTeams(teams []Team, activeMemberIDs []string) error {
+	if len(teams) < MinTeams || len(teams) > MaxTeams {
+		return ErrInvalidTeamCount
+	}
+
+	active := make(map[string]bool, len(activeMemberIDs))
+	for _, id := range activeMemberIDs {
+		active[id] = true
+	}
+
+	names := map[string]bool{}
+	assigned := map[string]bool{}
+	for _, t := range teams {
+		key := strings.ToLower(t.Name)
+		if names[key] {
+			return ErrDuplicateTeamName
+		}
+		names[key] = true
+
+		if len(t.MemberIDs) == 0 {
+			return ErrEmptyTeam
+		}
+		for _, id := range t.MemberIDs {
+			if !active[id] {
+				return ErrTeamMemberNotInGroup
+			}
+			if assigned[id] {
+				return ErrTeamMemberAssignedTwice
+			}
+			assigned[id] = true
+		}
+	}
+
+	if len(assigned) != len(active) {
+		return ErrTeamMemberUnassigned
+	}
+	return nil
+}
+
+// BalanceTeams distribui os membros embaralhados em rodízio: os times ficam com
+// tamanhos que diferem em no máximo 1. shuffle é rand.Shuffle (injetado pra ser determinístico).
+func BalanceTeams(teams []Team, memberIDs []string, shuffle func(n int, swap func(i, j int))) []Team {
+	ids := append([]string(nil), memberIDs...)
+	sort.Strings(ids)
+	shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+
+	out := make([]Team, len(teams))
+	for i, t := range teams {
+		t.MemberIDs = nil
+		out[i] = t
+	}
+	for i, id := range ids {
+		t := &out[i%len(out)]
+		t.MemberIDs = append(t.MemberIDs, id)
+	}
+	return out
+}
+
+// RankTeams soma os standings individuais por time. Quem não está em time nenhum
+// (entrou depois da divisão) fica fora do placar de times.
+// Desempate: maior score, depois nome do time; score igual divide a posição.
+func RankTeams(teams []Team, standings []Standing) []TeamStanding {
+	teamOf := map[string]int{}
+	for i, t := range teams {
+		for _, id := range t.MemberIDs {
+			teamOf[id] = i
+		}
+	}
+
+	out := make([]TeamStanding, len(teams))
+	for i, t := range teams {
+		out[i] = TeamStanding{Team: t, Members: []Standing{}}
+	}
+	// standings já vêm ordenados, então os membros de cada time também ficam
+	for _, st := range standings {
+		i, ok := teamOf[st.UserID]
+		if !ok {
+			continue
+		}
+		out[i].Score += st.Score
+		out[i].Members = append(out[i].Members, st)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		return out[i].Name < out[j].Name
+	})
+	for i := range out {
+		if i > 0 && out[i-1].Score == out[i].Score {
+			out[i].Rank = out[i-1].Rank
+			continue
+		}
+		out[i].Rank = i + 1
+	}
+	return out
+}
@@ -0,0 +1,154 @@
+package season
+
+import (
+	"strings"
+	"time"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
+
+// Period é a duração de cada season gerada por um template.
+type Period string
+
+const (
+	PeriodWeekly  Period = "WEEKLY"
+	PeriodMonthly Period = "MONTHLY"
+	// PeriodDays usa Template.PeriodDays.
+	PeriodDays Period = "DAYS"
+
+	MaxPeriodDays = 366
+)
+
+func (p Period) String() string {
+	return string(p)
+}
+
+// Template é a receita da season recorrente do grupo (no máximo um por grupo).
+// Quando uma season do grupo termina, o job agendado cria a próxima a partir dele.
+type Template struct {
+	ID           string
+	GroupID      string
+	Metric       Metric
+	Timezone     Timezone
+	Period       Period
+	PeriodDays   int // só pra PeriodDays; 0 nos outros
+	AutoActivate bool
+	// regras copiadas pra cada season gerada (mesmos padrões de uma season nova)
+	EditWindow      time.Duration
+	Qualification   Qualification
+	LateJoinPolicy  LateJoinPolicy
+	CreatedByUserID string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewTemplate(
+	id string,
+	groupID string,
+	metric Metric,
+	timezone Timezone,
+	period string,
+	periodDays int,
+	autoActivate bool,
+	createdByUserID string,
+	now time.Time,
+) (*Template, error) {
+	if _, err := timezone.Days(); err != nil {
+		return nil, err
+	}
+
+	p := Period(strings.ToUpper(strings.TrimSpace(period)))
+	switch p {
+	case PeriodWeekly, PeriodMonthly:
+		periodDays = 0
+	case PeriodDays:
+		if periodDays < 1 || periodDays > MaxPeriodDays {
+			return nil, ErrInvalidPeriod
+		}
+	default:
+		return nil, ErrInvalidPeriod
+	}
+
+	return &Template{
+		ID:              id,
+		GroupID:         groupID,
+		Metric:          metric,
+		Timezone:        timezone,
+		Period:          p,
+		PeriodDays:      periodDays,
+		AutoActivate:    autoActivate,
+		EditWindow:      readingDomain.DefaultEditWindow,
+		LateJoinPolicy:  LateJoinFromJoinDate,
+		CreatedByUserID: createdByUserID,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}, nil
+}
+
+// NextPeriod calcula a janela da próxima season: começa onde a anterior terminou e,
+// se o job ficou parado, avança período a período até cobrir now. O resultado só depende
+// de prevEnd e now, então duas execuções pro mesmo período chegam no mesmo start.
+func (t *Template) NextPeriod(prevEnd time.Time, now time.Time) (start time.Time, end time.Time, err error) {
+	days, err := t.Timezone.Days()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	start = days.In(prevEnd)
+	end = t.advance(start)
+	for !end.After(now) {
+		start = end
+		end = t.advance(start)
+	}
+	return start.UTC(), end.UTC(), nil
+}
+
+// advance soma um período no fuso do template (semana e mês seguem o calendário local).
+func (t *Template) advance(from time.Time) time.Time {
+	switch t.Period {
+	case PeriodWeekly:
+		return from.AddDate(0, 0, 7)
+	case PeriodMonthly:
+		return addMonth(from)
+	default:
+		return from.AddDate(0, 0, t.PeriodDays)
+	}
+}
+
+// addMonth avança um mês sem o AddDate normalizar 31/01 pra 03/03: o dia é limitado ao
+// último dia do mês seguinte, e quem termina no último dia do mês continua no último dia
+// (31/01 → 28/02 → 31/03), senão a recorrência ficaria presa no dia 28 pra sempre.
+func addMonth(from time.Time) time.Time {
+	y, m, d := from.Date()
+	hh, mm, ss := from.Clock()
+	last := daysIn(y, m+1, from.Location())
+	if d > last || d == daysIn(y, m, from.Location()) {
+		d = last
+	}
+	return time.Date(y, m+1, d, hh, mm, ss, from.Nanosecond(), from.Location())
+}
+
+// daysIn é o número de dias do mês (time.Date normaliza m = 13 pra janeiro do ano seguinte).
+func daysIn(y int, m time.Month, loc *time.Location) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, loc).Day()
+}
+
+// NextSeason monta a season do período com as regras do template; ACTIVE já iniciada
+// quando o template pede auto-ativação.
+func (t *Template) NextSeason(id string, endsAt time.Time, now time.Time) (*Season, error) {
+	s := New(id, t.GroupID, StatusDraft, nil, &endsAt, t.Timezone, t.Metric, t.CreatedByUserID, now)
+	// ainda DRAFT: as regras só mudam antes da ativação
+	s.EditWindow = t.EditWindow
+	if err := s.ChangeQualification(t.Qualification); err != nil {
+		return nil, err
+	}
+	if err := s.ChangeLateJoinPolicy(t.LateJoinPolicy); err != nil {
+		return nil, err
+	}
+	if t.AutoActivate {
+		if err := s.Activate(now); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,86 @@
+package season
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextSeasonCarriesTemplateRules(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tpl, err := NewTemplate("t1", "g1", MetricPages, Timezone("America/Sao_Paulo"), "WEEKLY", 0, true, "u1", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl.EditWindow = 30 * time.Minute
+	tpl.Qualification = Qualification{MinPages: 5, MinMinutes: 10, RequireGoal: true}
+	tpl.LateJoinPolicy = LateJoinProrated
+
+	s, err := tpl.NextSeason("s1", now.AddDate(0, 0, 7), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Status != StatusActive {
+		t.Errorf("Status = %s, want ACTIVE", s.Status)
+	}
+	if s.EditWindow != tpl.EditWindow {
+		t.Errorf("EditWindow = %s, want %s", s.EditWindow, tpl.EditWindow)
+	}
+	if s.Qualification != tpl.Qualification {
+		t.Errorf("Qualification = %+v, want %+v", s.Qualification, tpl.Qualification)
+	}
+	if s.LateJoinPolicy != LateJoinProrated {
+		t.Errorf("LateJoinPolicy = %s, want PRORATED", s.LateJoinPolicy)
+	}
+}
+
+func TestNextPeriodMonthlyKeepsMonthEnd(t *testing.T) {
+	tpl, err := NewTemplate("t1", "g1", MetricPages, Timezone("UTC"), "MONTHLY", 0, false, "u1", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		prevEnd time.Time
+		want    []time.Time
+	}{
+		{
+			"último dia do mês",
+			time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC),
+			[]time.Time{
+				time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2027, 4, 30, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			"dia 30 em fevereiro",
+			time.Date(2027, 1, 30, 0, 0, 0, 0, time.UTC),
+			[]time.Time{time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"começo do mês",
+			time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]time.Time{
+				time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prevEnd := tt.prevEnd
+			for _, want := range tt.want {
+				// now logo depois do início: cada chamada gera só o período seguinte
+				start, end, err := tpl.NextPeriod(prevEnd, prevEnd.Add(time.Hour))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !start.Equal(prevEnd) || !end.Equal(want) {
+					t.Fatalf("NextPeriod(%s) = [%s, %s), want [%s, %s)", prevEnd, start, end, prevEnd, want)
+				}
+				prevEnd = end
+			}
+		})
+	}
+}
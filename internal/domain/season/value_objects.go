@@ -1,6 +1,11 @@
 package season
 
-import "strings"
+import (
+	"strings"
+	"time"
+
+	readingDomain "reading-cats-api/internal/domain/reading"
+)
 
 type Status string
 
@@ -14,24 +19,50 @@ func (s Status) String() string {
 	return string(s)
 }
 
+// NewStatus valida um status vindo de fora (ex: filtro da listagem).
+func NewStatus(v string) (Status, error) {
+	st := Status(strings.ToUpper(strings.TrimSpace(v)))
+	switch st {
+	case StatusDraft, StatusActive, StatusEnded:
+		return st, nil
+	}
+	return "", ErrInvalidStatus
+}
+
 type Metric string
 
 const (
 	MetricCheckinsPerDay Metric = "CHECKINS_PER_DAY"
-	MetricPagesSoon      Metric = "PAGES_SOON"
-	MetricMinutesSoon    Metric = "MINUTES_SOON"
+	MetricPages          Metric = "PAGES"
+	MetricMinutes        Metric = "MINUTES"
 )
 
 func (m Metric) String() string {
 	return string(m)
 }
 
+// NewEditWindow converte edit_window_minutes (0 a 1440).
+func NewEditWindow(minutes int) (time.Duration, error) {
+	window := time.Duration(minutes) * time.Minute
+	if minutes < 0 || window > readingDomain.MaxEditWindow {
+		return 0, ErrInvalidEditWindow
+	}
+	return window, nil
+}
+
 type Timezone string
 
+// NewTimezone exige um fuso IANA de verdade; o mesmo nome é usado depois em todo cálculo de dia local.
 func NewTimezone(v string) (Timezone, error) {
 	v = strings.TrimSpace(v)
-	if v == "" {
+	if _, err := readingDomain.NewDayBoundary(v); err != nil {
 		return "", ErrInvalidTimezone
 	}
 	return Timezone(v), nil
 }
+
+// Days é a virada de dia no fuso da season; ends_at é um instante absoluto, o fuso só diz
+// em qual dia local ele cai.
+func (tz Timezone) Days() (readingDomain.DayBoundary, error) {
+	return readingDomain.NewDayBoundary(string(tz))
+}
@@ -2,10 +2,19 @@ package group
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	app "reading-cats-api/internal/application/group"
+	"reading-cats-api/internal/application/pagination"
 	domainGroup "reading-cats-api/internal/domain/group"
+	readingDomain "reading-cats-api/internal/domain/reading"
+	domainSeason "reading-cats-api/internal/domain/season"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -17,8 +26,21 @@ func NewPostgresRepository(pool *pgxpool.Pool) *PostgresRepository {
 	return &PostgresRepository{pool: pool}
 }
 
-func (r *PostgresRepository) Insert(ctx context.Context, g *domainGroup.Group) error {
-	_, err := r.pool.Exec(ctx,
+func (r *PostgresRepository) WithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresRepository) Insert(ctx context.Context, tx pgx.Tx, g *domainGroup.Group) error {
+	_, err := tx.Exec(ctx,
 		`INSERT INTO groups (id, name, icon_id, visibility, max_members, created_by_user_id, created_at, updated_at)
 		 VALUES ($1, $2, $3, $4::group_visibility, $5, $6, $7, $8)`,
 		g.ID,
@@ -36,16 +58,744 @@ func (r *PostgresRepository) Insert(ctx context.Context, g *domainGroup.Group) e
 	return nil
 }
 
-func (r *PostgresRepository) AddMember(ctx context.Context, groupID string, userID string, role string) error {
+// AddMember reaproveita a linha (group_id, user_id) de quem saiu em vez de criar outra.
+func (r *PostgresRepository) AddMember(ctx context.Context, tx pgx.Tx, groupID string, userID string, role domainGroup.Role) (bool, error) {
+	q := `
+INSERT INTO group_members (group_id, user_id, role, joined_at, left_at, is_active)
+VALUES ($1, $2, $3::group_member_role, now(), NULL, true)
+ON CONFLICT (group_id, user_id) DO UPDATE
+SET role = EXCLUDED.role, joined_at = now(), left_at = NULL, is_active = true
+WHERE NOT group_members.is_active
+RETURNING user_id`
+
+	var id string
+	err := tx.QueryRow(ctx, q, groupID, userID, role.String()).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// conflito com membro ativo: nada a fazer
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to add group member: %w", err)
+	}
+	return true, nil
+}
+
+// ListByMember lista os grupos em que o usuário é membro ativo, do ingresso mais recente pro mais antigo.
+func (r *PostgresRepository) ListByMember(ctx context.Context, userID string, after *pagination.Cursor, limit int) ([]app.MemberGroupRow, error) {
+	q := `
+SELECT g.id, g.name, g.icon_id, g.visibility::text, g.max_members, g.created_by_user_id, g.created_at, g.updated_at, g.archived_at,
+       gm.role::text, gm.joined_at,
+       (SELECT COUNT(*) FROM group_members m WHERE m.group_id = g.id AND m.is_active) AS member_count,
+       s.id, s.status::text, s.started_at, s.ends_at, s.timezone, s.metric::text, s.created_by_user_id, s.created_at, s.updated_at
+FROM group_members gm
+JOIN groups g ON g.id = gm.group_id
+LEFT JOIN group_seasons s ON s.group_id = g.id AND s.status = 'ACTIVE'
+WHERE gm.user_id = $1::uuid
+  AND gm.is_active
+  AND ($2::timestamptz IS NULL OR (gm.joined_at, g.id) < ($2::timestamptz, $3::uuid))
+ORDER BY gm.joined_at DESC, g.id DESC
+LIMIT $4`
+
+	var afterAt *time.Time
+	var afterID *string
+	if after != nil {
+		afterAt = &after.At
+		afterID = &after.ID
+	}
+
+	rows, err := r.pool.Query(ctx, q, userID, afterAt, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups by member: %w", err)
+	}
+	defer rows.Close()
+
+	out := []app.MemberGroupRow{}
+	for rows.Next() {
+		var row app.MemberGroupRow
+		var name, iconID, visibility, role string
+		var seasonID, seasonStatus, seasonTZ, seasonMetric, seasonCreatedBy *string
+		var seasonStartedAt, seasonEndsAt, seasonCreatedAt, seasonUpdatedAt *time.Time
+
+		err := rows.Scan(
+			&row.Group.ID, &name, &iconID, &visibility, &row.Group.MaxMembers, &row.Group.CreatedByUserID, &row.Group.CreatedAt, &row.Group.UpdatedAt, &row.Group.ArchivedAt,
+			&role, &row.JoinedAt,
+			&row.MemberCount,
+			&seasonID, &seasonStatus, &seasonStartedAt, &seasonEndsAt, &seasonTZ, &seasonMetric, &seasonCreatedBy, &seasonCreatedAt, &seasonUpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan member group: %w", err)
+		}
+
+		row.Group.Name = domainGroup.GroupName(name)
+		row.Group.IconID = domainGroup.IconID(iconID)
+		row.Group.Visibility = domainGroup.Visibility(visibility)
+		row.Role = domainGroup.Role(role)
+
+		if seasonID != nil {
+			row.ActiveSeason = &domainSeason.Season{
+				ID:              *seasonID,
+				GroupID:         row.Group.ID,
+				Status:          domainSeason.Status(*seasonStatus),
+				StartedAt:       seasonStartedAt,
+				EndsAt:          seasonEndsAt,
+				Timezone:        domainSeason.Timezone(*seasonTZ),
+				Metric:          domainSeason.Metric(*seasonMetric),
+				CreatedByUserID: *seasonCreatedBy,
+				CreatedAt:       *seasonCreatedAt,
+				UpdatedAt:       *seasonUpdatedAt,
+			}
+		}
+
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+// SearchPublic casa o nome por prefixo (ILIKE, case-insensitive) ou por similaridade trigram,
+// o que tolera erros de digitação. "Atividade" é o último check-in replicado pro grupo,
+// caindo pra data de criação em grupos ainda sem check-ins.
+func (r *PostgresRepository) SearchPublic(ctx context.Context, query string, after *pagination.Cursor, limit int) ([]app.PublicGroupRow, error) {
+	q := `
+WITH candidates AS (
+  SELECT g.id, g.name, g.icon_id, g.visibility::text, g.max_members, g.created_by_user_id, g.created_at, g.updated_at,
+         (SELECT COUNT(*) FROM group_members m WHERE m.group_id = g.id AND m.is_active) AS member_count,
+         COALESCE((SELECT MAX(gc.created_at) FROM group_checkins gc WHERE gc.group_id = g.id), g.created_at) AS last_activity_at
+  FROM groups g
+  WHERE g.visibility = 'PUBLIC_SOON'
+    AND g.archived_at IS NULL
+    AND ($1::text = '' OR g.name ILIKE $2 OR g.name % $1)
+)
+SELECT id, name, icon_id, visibility, max_members, created_by_user_id, created_at, updated_at, member_count, last_activity_at
+FROM candidates
+WHERE ($3::timestamptz IS NULL OR (last_activity_at, id) < ($3::timestamptz, $4::uuid))
+ORDER BY last_activity_at DESC, id DESC
+LIMIT $5`
+
+	var afterAt *time.Time
+	var afterID *string
+	if after != nil {
+		afterAt = &after.At
+		afterID = &after.ID
+	}
+
+	rows, err := r.pool.Query(ctx, q, query, likePrefix(query), afterAt, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search public groups: %w", err)
+	}
+	defer rows.Close()
+
+	out := []app.PublicGroupRow{}
+	for rows.Next() {
+		var row app.PublicGroupRow
+		g := &row.Group
+		var name, iconID, visibility string
+		if err := rows.Scan(&g.ID, &name, &iconID, &visibility, &g.MaxMembers, &g.CreatedByUserID, &g.CreatedAt, &g.UpdatedAt,
+			&row.MemberCount, &row.LastActivityAt); err != nil {
+			return nil, fmt.Errorf("failed to scan public group: %w", err)
+		}
+		g.Name = domainGroup.GroupName(name)
+		g.IconID = domainGroup.IconID(iconID)
+		g.Visibility = domainGroup.Visibility(visibility)
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+// likePrefix escapa os curingas do LIKE pra que o texto do usuário seja tratado literalmente.
+func likePrefix(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(v) + "%"
+}
+
+const selectGroup = `
+SELECT id, name, icon_id, visibility::text, max_members, created_by_user_id, created_at, updated_at, archived_at
+FROM groups
+WHERE id = $1::uuid`
+
+// FindByID retorna nil quando o grupo não existe.
+func (r *PostgresRepository) FindByID(ctx context.Context, groupID string) (*domainGroup.Group, error) {
+	g, err := scanGroup(r.pool.QueryRow(ctx, selectGroup, groupID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find group: %w", err)
+	}
+	return g, nil
+}
+
+// LockGroup lê o grupo com FOR UPDATE, serializando alterações de membros na transação.
+func (r *PostgresRepository) LockGroup(ctx context.Context, tx pgx.Tx, groupID string) (*domainGroup.Group, error) {
+	g, err := scanGroup(tx.QueryRow(ctx, selectGroup+" FOR UPDATE", groupID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock group: %w", err)
+	}
+	return g, nil
+}
+
+func scanGroup(row pgx.Row) (*domainGroup.Group, error) {
+	var g domainGroup.Group
+	var name, iconID, visibility string
+	err := row.Scan(&g.ID, &name, &iconID, &visibility, &g.MaxMembers, &g.CreatedByUserID, &g.CreatedAt, &g.UpdatedAt, &g.ArchivedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g.Name = domainGroup.GroupName(name)
+	g.IconID = domainGroup.IconID(iconID)
+	g.Visibility = domainGroup.Visibility(visibility)
+	return &g, nil
+}
+
+// GetMember retorna a linha de group_members (ativa ou não) ou nil se o usuário nunca entrou no grupo.
+func (r *PostgresRepository) GetMember(ctx context.Context, groupID string, userID string) (*domainGroup.Member, error) {
+	q := `
+SELECT group_id, user_id, role::text, joined_at, left_at, is_active
+FROM group_members
+WHERE group_id = $1::uuid AND user_id = $2::uuid`
+
+	var m domainGroup.Member
+	var role string
+	err := r.pool.QueryRow(ctx, q, groupID, userID).
+		Scan(&m.GroupID, &m.UserID, &role, &m.JoinedAt, &m.LeftAt, &m.IsActive)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group member: %w", err)
+	}
+	m.Role = domainGroup.Role(role)
+	return &m, nil
+}
+
+// ListActiveMembers lista os membros ativos com perfil e o check-in pessoal na data informada.
+func (r *PostgresRepository) ListActiveMembers(ctx context.Context, groupID string, date readingDomain.LocalDate) ([]app.MemberRow, error) {
+	q := `
+SELECT gm.group_id, gm.user_id, gm.role::text, gm.joined_at, gm.left_at, gm.is_active,
+       COALESCE(u.display_name, ''), COALESCE(u.avatar_url, ''),
+       uc.id IS NOT NULL, COALESCE(uc.pages_total, 0)
+FROM group_members gm
+JOIN users u ON u.id = gm.user_id
+LEFT JOIN user_checkins uc ON uc.user_id = gm.user_id AND uc.local_date = $2::date
+WHERE gm.group_id = $1::uuid AND gm.is_active
+ORDER BY gm.joined_at ASC, gm.user_id ASC`
+
+	rows, err := r.pool.Query(ctx, q, groupID, date.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group members: %w", err)
+	}
+	defer rows.Close()
+
+	out := []app.MemberRow{}
+	for rows.Next() {
+		var row app.MemberRow
+		m := &row.Member
+		var role string
+		if err := rows.Scan(&m.GroupID, &m.UserID, &role, &m.JoinedAt, &m.LeftAt, &m.IsActive,
+			&row.DisplayName, &row.AvatarURL, &row.CheckedInToday, &row.PagesToday); err != nil {
+			return nil, fmt.Errorf("failed to scan group member: %w", err)
+		}
+		m.Role = domainGroup.Role(role)
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+func (r *PostgresRepository) CountActiveMembers(ctx context.Context, tx pgx.Tx, groupID string) (int, error) {
+	var n int
+	err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM group_members WHERE group_id = $1::uuid AND is_active`, groupID).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count group members: %w", err)
+	}
+	return n, nil
+}
+
+func (r *PostgresRepository) InsertInvite(ctx context.Context, inv *domainGroup.Invite) error {
 	_, err := r.pool.Exec(ctx,
-		`INSERT INTO group_members (group_id, user_id, role, is_active)
-		 VALUES ($1, $2, $3::group_member_role, true)`,
+		`INSERT INTO group_invites (id, group_id, code, created_by_user_id, expires_at, single_use, uses, created_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		inv.ID,
+		inv.GroupID,
+		string(inv.Code),
+		inv.CreatedByUserID,
+		inv.ExpiresAt,
+		inv.SingleUse,
+		inv.Uses,
+		inv.CreatedAt,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "group_invites_code_key" {
+		return app.ErrInviteCodeTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert invite: %w", err)
+	}
+	return nil
+}
+
+// LockInviteByCode retorna nil quando o código não existe.
+func (r *PostgresRepository) LockInviteByCode(ctx context.Context, tx pgx.Tx, code domainGroup.InviteCode) (*domainGroup.Invite, error) {
+	q := `
+SELECT id, group_id, code, created_by_user_id, expires_at, single_use, uses, created_at
+FROM group_invites
+WHERE code = $1
+FOR UPDATE`
+
+	var inv domainGroup.Invite
+	var c string
+	err := tx.QueryRow(ctx, q, string(code)).
+		Scan(&inv.ID, &inv.GroupID, &c, &inv.CreatedByUserID, &inv.ExpiresAt, &inv.SingleUse, &inv.Uses, &inv.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock invite: %w", err)
+	}
+	inv.Code = domainGroup.InviteCode(c)
+	return &inv, nil
+}
+
+func (r *PostgresRepository) IncrementInviteUses(ctx context.Context, tx pgx.Tx, inviteID string) error {
+	_, err := tx.Exec(ctx, `UPDATE group_invites SET uses = uses + 1 WHERE id = $1::uuid`, inviteID)
+	if err != nil {
+		return fmt.Errorf("failed to increment invite uses: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresRepository) CountActiveAdmins(ctx context.Context, tx pgx.Tx, groupID string) (int, error) {
+	var n int
+	err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM group_members WHERE group_id = $1::uuid AND is_active AND role = 'ADMIN'`, groupID).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count group admins: %w", err)
+	}
+	return n, nil
+}
+
+func (r *PostgresRepository) DeactivateMember(ctx context.Context, tx pgx.Tx, groupID string, userID string) (domainGroup.Role, bool, error) {
+	q := `
+UPDATE group_members
+SET is_active = false, left_at = now()
+WHERE group_id = $1::uuid AND user_id = $2::uuid AND is_active
+RETURNING role::text`
+
+	var role string
+	err := tx.QueryRow(ctx, q, groupID, userID).Scan(&role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to deactivate group member: %w", err)
+	}
+	return domainGroup.Role(role), true, nil
+}
+
+func (r *PostgresRepository) PromoteOldestMember(ctx context.Context, tx pgx.Tx, groupID string) (string, bool, error) {
+	q := `
+UPDATE group_members
+SET role = 'ADMIN'
+WHERE (group_id, user_id) = (
+  SELECT group_id, user_id
+  FROM group_members
+  WHERE group_id = $1::uuid AND is_active
+  ORDER BY joined_at ASC, user_id ASC
+  LIMIT 1
+)
+RETURNING user_id`
+
+	var userID string
+	err := tx.QueryRow(ctx, q, groupID).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to promote oldest member: %w", err)
+	}
+	return userID, true, nil
+}
+
+func (r *PostgresRepository) OldestActiveAdmin(ctx context.Context, tx pgx.Tx, groupID string) (string, bool, error) {
+	q := `
+SELECT user_id
+FROM group_members
+WHERE group_id = $1::uuid AND is_active AND role = 'ADMIN'
+ORDER BY joined_at ASC, user_id ASC
+LIMIT 1`
+
+	var userID string
+	err := tx.QueryRow(ctx, q, groupID).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to find oldest admin: %w", err)
+	}
+	return userID, true, nil
+}
+
+func (r *PostgresRepository) UpdateMemberRole(ctx context.Context, tx pgx.Tx, groupID string, userID string, role domainGroup.Role) error {
+	_, err := tx.Exec(ctx,
+		`UPDATE group_members SET role = $3::group_member_role WHERE group_id = $1::uuid AND user_id = $2::uuid`,
+		groupID, userID, role.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update member role: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresRepository) UpdateSettings(ctx context.Context, tx pgx.Tx, g *domainGroup.Group) (time.Time, error) {
+	var updatedAt time.Time
+	err := tx.QueryRow(ctx,
+		`UPDATE groups SET name = $2, icon_id = $3, max_members = $4 WHERE id = $1::uuid RETURNING updated_at`,
+		g.ID, string(g.Name), string(g.IconID), g.MaxMembers,
+	).Scan(&updatedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to update group settings: %w", err)
+	}
+	return updatedAt, nil
+}
+
+func (r *PostgresRepository) Archive(ctx context.Context, tx pgx.Tx, groupID string, at time.Time) (time.Time, error) {
+	var updatedAt time.Time
+	err := tx.QueryRow(ctx,
+		`UPDATE groups SET archived_at = $2 WHERE id = $1::uuid RETURNING updated_at`,
+		groupID, at,
+	).Scan(&updatedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to archive group: %w", err)
+	}
+	return updatedAt, nil
+}
+
+// EndActiveSeason fecha a season em andamento; ends_at vira o momento do encerramento
+// quando estava vazio ou ainda no futuro.
+func (r *PostgresRepository) EndActiveSeason(ctx context.Context, tx pgx.Tx, groupID string, at time.Time) (string, bool, error) {
+	var seasonID string
+	err := tx.QueryRow(ctx, `
+UPDATE group_seasons
+SET status = 'ENDED',
+    ends_at = CASE WHEN ends_at IS NULL OR ends_at > $2 THEN $2 ELSE ends_at END
+WHERE group_id = $1::uuid AND status = 'ACTIVE'
+RETURNING id`,
+		groupID, at,
+	).Scan(&seasonID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to end active season: %w", err)
+	}
+	return seasonID, true, nil
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, tx pgx.Tx, groupID string) error {
+	_, err := tx.Exec(ctx, `DELETE FROM groups WHERE id = $1::uuid`, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresRepository) UpdateOwner(ctx context.Context, tx pgx.Tx, groupID string, userID string) (time.Time, error) {
+	var updatedAt time.Time
+	err := tx.QueryRow(ctx,
+		`UPDATE groups SET created_by_user_id = $2::uuid WHERE id = $1::uuid RETURNING updated_at`,
+		groupID, userID,
+	).Scan(&updatedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to update group owner: %w", err)
+	}
+	return updatedAt, nil
+}
+
+const selectJoinRequest = `
+SELECT id, group_id, user_id, status::text, created_at, decided_at, decided_by_user_id
+FROM group_join_requests`
+
+func (r *PostgresRepository) InsertJoinRequest(ctx context.Context, jr *domainGroup.JoinRequest) error {
+	_, err := r.pool.Exec(ctx,
+		`INSERT INTO group_join_requests (id, group_id, user_id, status, created_at)
+		 VALUES ($1, $2, $3, $4::group_join_request_status, $5)`,
+		jr.ID,
+		jr.GroupID,
+		jr.UserID,
+		jr.Status.String(),
+		jr.CreatedAt,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "group_join_requests_one_pending_key" {
+		return app.ErrJoinRequestExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert join request: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresRepository) ListPendingJoinRequests(ctx context.Context, groupID string) ([]app.JoinRequestRow, error) {
+	q := `
+SELECT jr.id, jr.group_id, jr.user_id, jr.status::text, jr.created_at, jr.decided_at, jr.decided_by_user_id,
+       COALESCE(u.display_name, ''), COALESCE(u.avatar_url, '')
+FROM group_join_requests jr
+JOIN users u ON u.id = jr.user_id
+WHERE jr.group_id = $1::uuid AND jr.status = 'PENDING'
+ORDER BY jr.created_at ASC, jr.id ASC`
+
+	rows, err := r.pool.Query(ctx, q, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list join requests: %w", err)
+	}
+	defer rows.Close()
+
+	out := []app.JoinRequestRow{}
+	for rows.Next() {
+		var row app.JoinRequestRow
+		jr := &row.Request
+		var status string
+		if err := rows.Scan(&jr.ID, &jr.GroupID, &jr.UserID, &status, &jr.CreatedAt, &jr.DecidedAt, &jr.DecidedByUserID,
+			&row.DisplayName, &row.AvatarURL); err != nil {
+			return nil, fmt.Errorf("failed to scan join request: %w", err)
+		}
+		jr.Status = domainGroup.JoinRequestStatus(status)
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+func (r *PostgresRepository) LockJoinRequest(ctx context.Context, tx pgx.Tx, requestID string) (*domainGroup.JoinRequest, error) {
+	var jr domainGroup.JoinRequest
+	var status string
+	err := tx.QueryRow(ctx, selectJoinRequest+` WHERE id = $1::uuid FOR UPDATE`, requestID).
+		Scan(&jr.ID, &jr.GroupID, &jr.UserID, &status, &jr.CreatedAt, &jr.DecidedAt, &jr.DecidedByUserID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock join request: %w", err)
+	}
+	jr.Status = domainGroup.JoinRequestStatus(status)
+	return &jr, nil
+}
+
+func (r *PostgresRepository) UpdateJoinRequest(ctx context.Context, tx pgx.Tx, jr *domainGroup.JoinRequest) error {
+	_, err := tx.Exec(ctx,
+		`UPDATE group_join_requests
+		 SET status = $2::group_join_request_status, decided_at = $3, decided_by_user_id = $4
+		 WHERE id = $1::uuid`,
+		jr.ID, jr.Status.String(), jr.DecidedAt, jr.DecidedByUserID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update join request: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresRepository) ListActiveGroupIDsByUser(ctx context.Context, tx pgx.Tx, userID string) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+SELECT gm.group_id
+FROM group_members gm
+JOIN groups g ON g.id = gm.group_id
+WHERE gm.user_id = $1::uuid AND gm.is_active AND g.archived_at IS NULL`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user groups: %w", err)
+	}
+	defer rows.Close()
+
+	out := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan user group: %w", err)
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+func (r *PostgresRepository) InsertEvent(ctx context.Context, tx pgx.Tx, e *domainGroup.Event) error {
+	err := tx.QueryRow(ctx,
+		`INSERT INTO group_events (id, group_id, type, actor_user_id, season_id, data)
+		 VALUES ($1, $2, $3::group_event_type, NULLIF($4, '')::uuid, NULLIF($5, '')::uuid, $6)
+		 RETURNING created_at`,
+		e.ID,
+		e.GroupID,
+		e.Type.String(),
+		e.ActorUserID,
+		e.SeasonID,
+		e.Data,
+	).Scan(&e.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert group event: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresRepository) ListEvents(ctx context.Context, groupID string, after *pagination.Cursor, limit int) ([]app.EventRow, error) {
+	q := `
+SELECT e.id, e.group_id, e.type::text, COALESCE(e.actor_user_id::text, ''), COALESCE(e.season_id::text, ''), e.data, e.created_at,
+       COALESCE(u.display_name, ''), COALESCE(u.avatar_url, '')
+FROM group_events e
+LEFT JOIN users u ON u.id = e.actor_user_id
+WHERE e.group_id = $1::uuid
+  AND ($2::timestamptz IS NULL OR (e.created_at, e.id) < ($2::timestamptz, $3::uuid))
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT $4`
+
+	var afterAt *time.Time
+	var afterID *string
+	if after != nil {
+		afterAt = &after.At
+		afterID = &after.ID
+	}
+
+	rows, err := r.pool.Query(ctx, q, groupID, afterAt, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group events: %w", err)
+	}
+	defer rows.Close()
+
+	out := []app.EventRow{}
+	for rows.Next() {
+		var row app.EventRow
+		e := &row.Event
+		var eventType string
+		if err := rows.Scan(&e.ID, &e.GroupID, &eventType, &e.ActorUserID, &e.SeasonID, &e.Data, &e.CreatedAt,
+			&row.ActorDisplayName, &row.ActorAvatarURL); err != nil {
+			return nil, fmt.Errorf("failed to scan group event: %w", err)
+		}
+		e.Type = domainGroup.EventType(eventType)
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+// ListSeasonsByUserCheckin lê as seasons em que o dia entrou (contando ou não), com FOR SHARE pra
+// ninguém encerrar a season no meio da correção.
+func (r *PostgresRepository) ListSeasonsByUserCheckin(ctx context.Context, tx pgx.Tx, userCheckinID string) ([]domainSeason.Season, error) {
+	rows, err := tx.Query(ctx, `
+SELECT s.id, s.group_id, s.status::text, s.started_at, s.ends_at, s.timezone, s.metric::text, s.edit_window_minutes,
+       s.min_pages, s.min_minutes, s.require_goal, s.late_join_policy::text,
+       s.created_by_user_id, s.created_at, s.updated_at
+FROM group_seasons s
+WHERE s.id IN (SELECT DISTINCT season_id FROM group_checkins WHERE user_checkin_id = $1::uuid)
+FOR SHARE OF s`,
+		userCheckinID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons by checkin: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSeasons(rows)
+}
+
+// scanSeasons lê linhas com as colunas na ordem de ListActiveSeasonsByUser.
+func scanSeasons(rows pgx.Rows) ([]domainSeason.Season, error) {
+	out := []domainSeason.Season{}
+	for rows.Next() {
+		var s domainSeason.Season
+		var status, timezone, metric, lateJoin string
+		var editWindow int
+		if err := rows.Scan(&s.ID, &s.GroupID, &status, &s.StartedAt, &s.EndsAt, &timezone, &metric, &editWindow,
+			&s.Qualification.MinPages, &s.Qualification.MinMinutes, &s.Qualification.RequireGoal, &lateJoin,
+			&s.CreatedByUserID, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan season: %w", err)
+		}
+		s.Status = domainSeason.Status(status)
+		s.Timezone = domainSeason.Timezone(timezone)
+		s.Metric = domainSeason.Metric(metric)
+		s.EditWindow = time.Duration(editWindow) * time.Minute
+		s.LateJoinPolicy = domainSeason.LateJoinPolicy(lateJoin)
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+// FindActiveSeasonByGroup retorna nil quando o grupo não tem season ACTIVE.
+func (r *PostgresRepository) FindActiveSeasonByGroup(ctx context.Context, tx pgx.Tx, groupID string) (*domainSeason.Season, error) {
+	rows, err := tx.Query(ctx, `
+SELECT s.id, s.group_id, s.status::text, s.started_at, s.ends_at, s.timezone, s.metric::text, s.edit_window_minutes,
+       s.min_pages, s.min_minutes, s.require_goal, s.late_join_policy::text,
+       s.created_by_user_id, s.created_at, s.updated_at
+FROM group_seasons s
+WHERE s.group_id = $1::uuid AND s.status = 'ACTIVE'`,
 		groupID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active season: %w", err)
+	}
+	defer rows.Close()
+
+	seasons, err := scanSeasons(rows)
+	if err != nil || len(seasons) == 0 {
+		return nil, err
+	}
+	return &seasons[0], nil
+}
+
+// AddSeasonParticipant é idempotente: quem sai e volta mantém a entrada original.
+func (r *PostgresRepository) AddSeasonParticipant(ctx context.Context, tx pgx.Tx, p domainSeason.Participant) error {
+	_, err := tx.Exec(ctx, `
+INSERT INTO season_participants (season_id, user_id, joined_at, late)
+VALUES ($1::uuid, $2::uuid, $3, $4)
+ON CONFLICT (season_id, user_id) DO NOTHING`,
+		p.SeasonID, p.UserID, p.JoinedAt, p.Late,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add season participant: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresRepository) ListActiveSeasonsByUser(ctx context.Context, tx pgx.Tx, userID string) ([]domainSeason.Season, error) {
+	rows, err := tx.Query(ctx, `
+SELECT s.id, s.group_id, s.status::text, s.started_at, s.ends_at, s.timezone, s.metric::text, s.edit_window_minutes,
+       s.min_pages, s.min_minutes, s.require_goal, s.late_join_policy::text,
+       s.created_by_user_id, s.created_at, s.updated_at
+FROM group_members gm
+JOIN groups g ON g.id = gm.group_id
+JOIN group_seasons s ON s.group_id = g.id AND s.status = 'ACTIVE'
+JOIN season_participants sp ON sp.season_id = s.id AND sp.user_id = gm.user_id
+WHERE gm.user_id = $1::uuid AND gm.is_active AND g.archived_at IS NULL`,
 		userID,
-		role,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to add group member: %w", err)
+		return nil, fmt.Errorf("failed to list active seasons by user: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSeasons(rows)
+}
+
+// InsertCheckin ignora o conflito em (season_id, user_checkin_id): registros seguintes no
+// mesmo dia pessoal só somam páginas no user_checkins já referenciado. Um dia desmarcado
+// por correção volta a contar.
+func (r *PostgresRepository) InsertCheckin(ctx context.Context, tx pgx.Tx, c domainSeason.Checkin) (bool, error) {
+	tag, err := tx.Exec(ctx,
+		`INSERT INTO group_checkins (group_id, season_id, user_id, user_checkin_id, local_date)
+		 VALUES ($1::uuid, $2::uuid, $3::uuid, $4::uuid, $5::date)
+		 ON CONFLICT (season_id, user_checkin_id) DO UPDATE SET qualified = true
+		 WHERE NOT group_checkins.qualified`,
+		c.GroupID, c.SeasonID, c.UserID, c.UserCheckinID, c.LocalDate.String(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert group checkin: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *PostgresRepository) SetCheckinQualified(ctx context.Context, tx pgx.Tx, seasonID string, userCheckinID string, qualified bool) error {
+	_, err := tx.Exec(ctx,
+		`UPDATE group_checkins SET qualified = $3
+		 WHERE season_id = $1::uuid AND user_checkin_id = $2::uuid`,
+		seasonID, userCheckinID, qualified,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update group checkin: %w", err)
 	}
 	return nil
 }
@@ -41,16 +41,17 @@ func (r *PostgresRepository) ExistsDay(ctx context.Context, tx pgx.Tx, userID st
 }
 
 func (r *PostgresRepository) GetDay(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (app.DayRow, bool, error) {
-	q := `SELECT pages_total, streak_days FROM user_checkins WHERE user_id=$1::uuid AND local_date=$2::date LIMIT 1`
-	var pages, streak int
-	err := tx.QueryRow(ctx, q, userID, date.String()).Scan(&pages, &streak)
+	// FOR UPDATE: registro, correção e exclusão do mesmo dia passam um de cada vez
+	q := `SELECT id, pages_total, minutes_total, streak_days, logged_at, created_at FROM user_checkins WHERE user_id=$1::uuid AND local_date=$2::date LIMIT 1 FOR UPDATE`
+	day := app.DayRow{Date: date}
+	err := tx.QueryRow(ctx, q, userID, date.String()).Scan(&day.ID, &day.Pages, &day.Minutes, &day.StreakDays, &day.LoggedAt, &day.FirstLoggedAt)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return app.DayRow{}, false, nil
 	}
 	if err != nil {
 		return app.DayRow{}, false, err
 	}
-	return app.DayRow{Date: date, Pages: pages, StreakDays: streak}, true, nil
+	return day, true, nil
 }
 
 func (r *PostgresRepository) GetLastDayBefore(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (app.LastDayRow, bool, error) {
@@ -72,42 +73,96 @@ LIMIT 1`
 	return app.LastDayRow{Date: readingDomain.LocalDate(d), StreakDays: streak}, true, nil
 }
 
-func (r *PostgresRepository) AddPages(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, delta int) (app.DayRow, error) {
+func (r *PostgresRepository) AddReading(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, pages int, minutes int) (app.DayRow, error) {
 	q := `
 UPDATE user_checkins
-SET pages_total = pages_total + $3
+SET pages_total = pages_total + $3, minutes_total = minutes_total + $4, logged_at = now()
 WHERE user_id=$1::uuid AND local_date=$2::date
-RETURNING pages_total, streak_days`
-	var pages, streak int
-	if err := tx.QueryRow(ctx, q, userID, date.String(), delta).Scan(&pages, &streak); err != nil {
+RETURNING id, pages_total, minutes_total, streak_days, logged_at, created_at`
+	day := app.DayRow{Date: date}
+	if err := tx.QueryRow(ctx, q, userID, date.String(), pages, minutes).Scan(&day.ID, &day.Pages, &day.Minutes, &day.StreakDays, &day.LoggedAt, &day.FirstLoggedAt); err != nil {
 		return app.DayRow{}, err
 	}
-	return app.DayRow{Date: date, Pages: pages, StreakDays: streak}, nil
+	return day, nil
 }
 
-func (r *PostgresRepository) InsertDay(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, pagesTotal int, streakDays int) (app.DayRow, error) {
+func (r *PostgresRepository) InsertDay(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, pagesTotal int, minutesTotal int, streakDays int) (app.DayRow, error) {
 	q := `
-INSERT INTO user_checkins (user_id, local_date, pages_total, streak_days, created_at, updated_at)
-VALUES ($1::uuid, $2::date, $3, $4, now(), now())
-RETURNING pages_total, streak_days`
-	var pages, streak int
-	err := tx.QueryRow(ctx, q, userID, date.String(), pagesTotal, streakDays).Scan(&pages, &streak)
+INSERT INTO user_checkins (user_id, local_date, pages_total, minutes_total, streak_days, logged_at, created_at, updated_at)
+VALUES ($1::uuid, $2::date, $3, $4, $5, now(), now(), now())
+RETURNING id, pages_total, minutes_total, streak_days, logged_at, created_at`
+	day := app.DayRow{Date: date}
+	err := tx.QueryRow(ctx, q, userID, date.String(), pagesTotal, minutesTotal, streakDays).Scan(&day.ID, &day.Pages, &day.Minutes, &day.StreakDays, &day.LoggedAt, &day.FirstLoggedAt)
 	if err == nil {
-		return app.DayRow{Date: date, Pages: pages, StreakDays: streak}, nil
+		return day, nil
 	}
 
 	// Race: outra request inseriu o mesmo dia entre GetDay e InsertDay
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-		return r.AddPages(ctx, tx, userID, date, pagesTotal)
+		return r.AddReading(ctx, tx, userID, date, pagesTotal, minutesTotal)
 	}
 
 	return app.DayRow{}, err
 }
 
-func (r *PostgresRepository) GetDaysBetween(ctx context.Context, tx pgx.Tx, userID string, start, end readingDomain.LocalDate) (map[readingDomain.LocalDate]int, error) {
+// SetDayTotals sobrescreve os totais do dia (correção). logged_at fica como está:
+// corrigir não reabre a janela.
+func (r *PostgresRepository) SetDayTotals(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate, pagesTotal int, minutesTotal int) (app.DayRow, error) {
 	q := `
-SELECT local_date::text, pages_total
+UPDATE user_checkins
+SET pages_total = $3, minutes_total = $4
+WHERE user_id=$1::uuid AND local_date=$2::date
+RETURNING id, pages_total, minutes_total, streak_days, logged_at, created_at`
+	day := app.DayRow{Date: date}
+	if err := tx.QueryRow(ctx, q, userID, date.String(), pagesTotal, minutesTotal).Scan(&day.ID, &day.Pages, &day.Minutes, &day.StreakDays, &day.LoggedAt, &day.FirstLoggedAt); err != nil {
+		return app.DayRow{}, err
+	}
+	return day, nil
+}
+
+// DeleteDay apaga o dia; os group_checkins que apontam pra ele caem junto (ON DELETE CASCADE).
+func (r *PostgresRepository) DeleteDay(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) error {
+	_, err := tx.Exec(ctx, `DELETE FROM user_checkins WHERE user_id=$1::uuid AND local_date=$2::date`, userID, date.String())
+	return err
+}
+
+func (r *PostgresRepository) InsertLog(ctx context.Context, tx pgx.Tx, userID string, e *readingDomain.LogEntry) error {
+	q := `
+INSERT INTO reading_logs (user_checkin_id, user_id, local_date, pages, minutes, source, logged_at)
+VALUES ($1::uuid, $2::uuid, $3::date, $4, $5, $6, $7)
+RETURNING id`
+	return tx.QueryRow(ctx, q, e.UserCheckinID, userID, e.Date.String(), e.Pages, e.Minutes, e.Source.String(), e.LoggedAt).Scan(&e.ID)
+}
+
+func (r *PostgresRepository) ListLogs(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) ([]readingDomain.LogEntry, error) {
+	q := `
+SELECT id, user_checkin_id, pages, minutes, source, logged_at
+FROM reading_logs
+WHERE user_id=$1::uuid AND local_date=$2::date
+ORDER BY logged_at, id`
+	rows, err := tx.Query(ctx, q, userID, date.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []readingDomain.LogEntry{}
+	for rows.Next() {
+		e := readingDomain.LogEntry{Date: date}
+		var source string
+		if err := rows.Scan(&e.ID, &e.UserCheckinID, &e.Pages, &e.Minutes, &source, &e.LoggedAt); err != nil {
+			return nil, err
+		}
+		e.Source = readingDomain.Source(source)
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+func (r *PostgresRepository) GetDaysBetween(ctx context.Context, tx pgx.Tx, userID string, start, end readingDomain.LocalDate) (map[readingDomain.LocalDate]app.DayRow, error) {
+	q := `
+SELECT id, local_date::text, pages_total, minutes_total, streak_days
 FROM user_checkins
 WHERE user_id=$1::uuid AND local_date BETWEEN $2::date AND $3::date`
 	rows, err := tx.Query(ctx, q, userID, start.String(), end.String())
@@ -116,74 +171,95 @@ WHERE user_id=$1::uuid AND local_date BETWEEN $2::date AND $3::date`
 	}
 	defer rows.Close()
 
-	out := map[readingDomain.LocalDate]int{}
+	out := map[readingDomain.LocalDate]app.DayRow{}
 	for rows.Next() {
+		var day app.DayRow
 		var d string
-		var p int
-		if err := rows.Scan(&d, &p); err != nil {
+		if err := rows.Scan(&day.ID, &d, &day.Pages, &day.Minutes, &day.StreakDays); err != nil {
 			return nil, err
 		}
-		out[readingDomain.LocalDate(d)] = p
+		day.Date = readingDomain.LocalDate(d)
+		out[day.Date] = day
 	}
 	return out, rows.Err()
 }
 
 // GetCurrentGoal retorna o goal vigente (start_date <= agora) ou nil se não existe
-func (r *PostgresRepository) GetCurrentGoal(ctx context.Context, tx pgx.Tx, userID string) (int, bool, error) {
+func (r *PostgresRepository) GetCurrentGoal(ctx context.Context, tx pgx.Tx, userID string) (readingDomain.DailyGoal, bool, error) {
 	q := `
-SELECT daily_pages 
+SELECT COALESCE(daily_pages, 0), COALESCE(daily_minutes, 0)
 FROM reading_goal 
 WHERE user_id=$1::uuid AND start_date <= now()
 ORDER BY start_date DESC
 LIMIT 1
 `
-	var pages int
-	err := tx.QueryRow(ctx, q, userID).Scan(&pages)
+	var g readingDomain.DailyGoal
+	err := tx.QueryRow(ctx, q, userID).Scan(&g.Pages, &g.Minutes)
 	if errors.Is(err, pgx.ErrNoRows) {
-		return 0, false, nil
+		return readingDomain.DailyGoal{}, false, nil
 	}
 	if err != nil {
-		return 0, false, err
+		return readingDomain.DailyGoal{}, false, err
 	}
-	return pages, true, nil
+	return g, true, nil
 }
 
 // GetNextGoal retorna o goal para uma data futura ou nil se não existe
-func (r *PostgresRepository) GetNextGoal(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (int, bool, error) {
+func (r *PostgresRepository) GetNextGoal(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (readingDomain.DailyGoal, bool, error) {
 	q := `
-SELECT daily_pages 
+SELECT COALESCE(daily_pages, 0), COALESCE(daily_minutes, 0)
 FROM reading_goal 
 WHERE user_id=$1::uuid AND start_date::date = $2::date
 LIMIT 1
 `
-	var pages int
-	err := tx.QueryRow(ctx, q, userID, date.String()).Scan(&pages)
+	var g readingDomain.DailyGoal
+	err := tx.QueryRow(ctx, q, userID, date.String()).Scan(&g.Pages, &g.Minutes)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return readingDomain.DailyGoal{}, false, nil
+	}
+	if err != nil {
+		return readingDomain.DailyGoal{}, false, err
+	}
+	return g, true, nil
+}
+
+// GetGoalOn retorna o goal em vigor na data (start_date <= date) ou nil se não existe
+func (r *PostgresRepository) GetGoalOn(ctx context.Context, tx pgx.Tx, userID string, date readingDomain.LocalDate) (readingDomain.DailyGoal, bool, error) {
+	q := `
+SELECT COALESCE(daily_pages, 0), COALESCE(daily_minutes, 0)
+FROM reading_goal
+WHERE user_id=$1::uuid AND start_date::date <= $2::date
+ORDER BY start_date DESC
+LIMIT 1
+`
+	var g readingDomain.DailyGoal
+	err := tx.QueryRow(ctx, q, userID, date.String()).Scan(&g.Pages, &g.Minutes)
 	if errors.Is(err, pgx.ErrNoRows) {
-		return 0, false, nil
+		return readingDomain.DailyGoal{}, false, nil
 	}
 	if err != nil {
-		return 0, false, err
+		return readingDomain.DailyGoal{}, false, err
 	}
-	return pages, true, nil
+	return g, true, nil
 }
 
-// InsertGoal inserts a new goal record
-func (r *PostgresRepository) InsertGoal(ctx context.Context, tx pgx.Tx, userID string, pages int, startDate readingDomain.LocalDate) error {
+// InsertGoal inserts a new goal record (unidade sem meta vai como NULL)
+func (r *PostgresRepository) InsertGoal(ctx context.Context, tx pgx.Tx, userID string, goal readingDomain.DailyGoal, startDate readingDomain.LocalDate) error {
 	q := `
-INSERT INTO reading_goal (user_id, daily_pages, start_date, created_at)
-VALUES ($1::uuid, $2, $3::date, now())
+INSERT INTO reading_goal (user_id, daily_pages, daily_minutes, start_date, created_at)
+VALUES ($1::uuid, NULLIF($2, 0), NULLIF($3, 0), $4::date, now())
 `
-	_, err := tx.Exec(ctx, q, userID, pages, startDate.String())
+	_, err := tx.Exec(ctx, q, userID, goal.Pages, goal.Minutes, startDate.String())
 	return err
 }
 
-// UpdateGoalPages updates the pages for a specific goal date
-func (r *PostgresRepository) UpdateGoalPages(ctx context.Context, tx pgx.Tx, userID string, pages int, startDate readingDomain.LocalDate) error {
+// UpdateGoal replaces the goal for a specific goal date
+func (r *PostgresRepository) UpdateGoal(ctx context.Context, tx pgx.Tx, userID string, goal readingDomain.DailyGoal, startDate readingDomain.LocalDate) error {
 	q := `
 UPDATE reading_goal
-SET daily_pages = $1
-WHERE user_id = $2::uuid AND start_date::date = $3::date
+SET daily_pages = NULLIF($1, 0), daily_minutes = NULLIF($2, 0)
+WHERE user_id = $3::uuid AND start_date::date = $4::date
 `
-	_, err := tx.Exec(ctx, q, pages, userID, startDate.String())
+	_, err := tx.Exec(ctx, q, goal.Pages, goal.Minutes, userID, startDate.String())
 	return err
 }
@@ -2,10 +2,17 @@ package season
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"reading-cats-api/internal/application/pagination"
+	app "reading-cats-api/internal/application/season"
+	readingDomain "reading-cats-api/internal/domain/reading"
 	domainSeason "reading-cats-api/internal/domain/season"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -17,10 +24,23 @@ func NewPostgresRepository(pool *pgxpool.Pool) *PostgresRepository {
 	return &PostgresRepository{pool: pool}
 }
 
-func (r *PostgresRepository) Insert(ctx context.Context, s *domainSeason.Season) error {
-	_, err := r.pool.Exec(ctx,
-		`INSERT INTO group_seasons (id, group_id, status, started_at, ends_at, timezone, metric, created_by_user_id, created_at, updated_at)
-		 VALUES ($1, $2, $3::group_season_status, $4, $5, $6, $7::group_metric, $8, $9, $10)`,
+func (r *PostgresRepository) WithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresRepository) Insert(ctx context.Context, tx pgx.Tx, s *domainSeason.Season) error {
+	_, err := tx.Exec(ctx,
+		`INSERT INTO group_seasons (id, group_id, status, started_at, ends_at, timezone, metric, edit_window_minutes, min_pages, min_minutes, require_goal, late_join_policy, created_by_user_id, created_at, updated_at)
+		 VALUES ($1, $2, $3::group_season_status, $4, $5, $6, $7::group_metric, $8, $9, $10, $11, $12::season_late_join_policy, $13, $14, $15)`,
 		s.ID,
 		s.GroupID,
 		s.Status.String(),
@@ -28,6 +48,11 @@ func (r *PostgresRepository) Insert(ctx context.Context, s *domainSeason.Season)
 		s.EndsAt,
 		string(s.Timezone),
 		s.Metric.String(),
+		int(s.EditWindow/time.Minute),
+		s.Qualification.MinPages,
+		s.Qualification.MinMinutes,
+		s.Qualification.RequireGoal,
+		s.LateJoinPolicy.String(),
 		s.CreatedByUserID,
 		s.CreatedAt,
 		s.UpdatedAt,
@@ -37,3 +62,564 @@ func (r *PostgresRepository) Insert(ctx context.Context, s *domainSeason.Season)
 	}
 	return nil
 }
+
+const selectSeason = `
+SELECT id, group_id, status::text, started_at, ends_at, timezone, metric::text, edit_window_minutes, min_pages, min_minutes, require_goal, late_join_policy::text, created_by_user_id, created_at, updated_at
+FROM group_seasons`
+
+func (r *PostgresRepository) FindByID(ctx context.Context, seasonID string) (*domainSeason.Season, error) {
+	s, err := scanSeason(r.pool.QueryRow(ctx, selectSeason+` WHERE id = $1::uuid`, seasonID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find season: %w", err)
+	}
+	return s, nil
+}
+
+func (r *PostgresRepository) FindActiveByGroup(ctx context.Context, groupID string) (*domainSeason.Season, error) {
+	s, err := scanSeason(r.pool.QueryRow(ctx, selectSeason+` WHERE group_id = $1::uuid AND status = 'ACTIVE'`, groupID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active season: %w", err)
+	}
+	return s, nil
+}
+
+func (r *PostgresRepository) ListByGroup(ctx context.Context, groupID string, status domainSeason.Status, after *pagination.Cursor, limit int) ([]*domainSeason.Season, error) {
+	q := selectSeason + `
+WHERE group_id = $1::uuid
+  AND ($2::text = '' OR status::text = $2::text)
+  AND ($3::timestamptz IS NULL OR (created_at, id) < ($3::timestamptz, $4::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $5`
+
+	var afterAt *time.Time
+	var afterID *string
+	if after != nil {
+		afterAt = &after.At
+		afterID = &after.ID
+	}
+
+	rows, err := r.pool.Query(ctx, q, groupID, status.String(), afterAt, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons: %w", err)
+	}
+	defer rows.Close()
+
+	out := []*domainSeason.Season{}
+	for rows.Next() {
+		s, err := scanSeason(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan season: %w", err)
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+func (r *PostgresRepository) ListWinners(ctx context.Context, seasonIDs []string) ([]app.WinnerRow, error) {
+	if len(seasonIDs) == 0 {
+		return []app.WinnerRow{}, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+SELECT season_id, user_id, display_name, avatar_url, score
+FROM season_results
+WHERE season_id = ANY($1::uuid[]) AND rank = 1 AND score > 0
+ORDER BY season_id, user_id`, seasonIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list season winners: %w", err)
+	}
+	defer rows.Close()
+
+	out := []app.WinnerRow{}
+	for rows.Next() {
+		var w app.WinnerRow
+		if err := rows.Scan(&w.SeasonID, &w.UserID, &w.DisplayName, &w.AvatarURL, &w.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan season winner: %w", err)
+		}
+		out = append(out, w)
+	}
+	return out, rows.Err()
+}
+
+func (r *PostgresRepository) LockByID(ctx context.Context, tx pgx.Tx, seasonID string) (*domainSeason.Season, error) {
+	s, err := scanSeason(tx.QueryRow(ctx, selectSeason+` WHERE id = $1::uuid FOR UPDATE`, seasonID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock season: %w", err)
+	}
+	return s, nil
+}
+
+func (r *PostgresRepository) ListExpiredActiveIDs(ctx context.Context, now time.Time) ([]string, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT id FROM group_seasons
+		 WHERE status = 'ACTIVE' AND ends_at IS NOT NULL AND ends_at <= $1
+		 ORDER BY ends_at ASC`,
+		now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired seasons: %w", err)
+	}
+	defer rows.Close()
+
+	out := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan expired season: %w", err)
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+func (r *PostgresRepository) UpdateState(ctx context.Context, tx pgx.Tx, s *domainSeason.Season) (time.Time, error) {
+	var updatedAt time.Time
+	err := tx.QueryRow(ctx,
+		`UPDATE group_seasons SET status = $2::group_season_status, started_at = $3, ends_at = $4
+		 WHERE id = $1::uuid RETURNING updated_at`,
+		s.ID, s.Status.String(), s.StartedAt, s.EndsAt,
+	).Scan(&updatedAt)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_group_seasons_one_active_per_group" {
+		return time.Time{}, domainSeason.ErrActiveSeasonExists
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to update season state: %w", err)
+	}
+	return updatedAt, nil
+}
+
+// ListParticipantStats usa idx_group_checkins_season_user (season_id, user_id).
+// A sequência que termina no último check-in sai de "gaps and islands": em dias consecutivos,
+// local_date - row_number é constante.
+func (r *PostgresRepository) ListParticipantStats(ctx context.Context, groupID string, seasonID string) ([]app.ParticipantRow, error) {
+	return listParticipantStats(ctx, r.pool, groupID, seasonID)
+}
+
+// ListParticipantStatsTx é a mesma leitura dentro da transação que encerra a season.
+func (r *PostgresRepository) ListParticipantStatsTx(ctx context.Context, tx pgx.Tx, groupID string, seasonID string) ([]app.ParticipantRow, error) {
+	return listParticipantStats(ctx, tx, groupID, seasonID)
+}
+
+// querier cobre pool e transação.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func listParticipantStats(ctx context.Context, db querier, groupID string, seasonID string) ([]app.ParticipantRow, error) {
+	q := `
+WITH days AS (
+  SELECT DISTINCT user_id, local_date
+  FROM group_checkins
+  WHERE season_id = $2::uuid AND qualified
+),
+stats AS (
+  SELECT user_id, COUNT(*) AS checkin_days, MAX(local_date) AS last_date
+  FROM days
+  GROUP BY user_id
+),
+runs AS (
+  SELECT user_id, COUNT(*) AS run_days, MAX(local_date) AS run_end
+  FROM (
+    SELECT user_id, local_date,
+           local_date - (ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY local_date))::int AS island
+    FROM days
+  ) d
+  GROUP BY user_id, island
+),
+longest AS (
+  SELECT user_id, MAX(run_days) AS longest_days
+  FROM runs
+  GROUP BY user_id
+),
+pages AS (
+  -- cada user_checkins entra uma vez por season; dois dias pessoais no mesmo dia local somam os dois
+  SELECT x.user_id, SUM(uc.pages_total) AS pages, SUM(uc.minutes_total) AS minutes,
+         MAX(uc.pages_total) AS best_pages, MAX(uc.minutes_total) AS best_minutes
+  FROM (SELECT user_id, user_checkin_id FROM group_checkins WHERE season_id = $2::uuid AND qualified) x
+  JOIN user_checkins uc ON uc.id = x.user_checkin_id
+  GROUP BY x.user_id
+),
+participants AS (
+  -- roster da season; DRAFT ainda não tem roster, então a prévia mostra os membros ativos
+  SELECT user_id, joined_at, late FROM season_participants WHERE season_id = $2::uuid
+  UNION ALL
+  SELECT gm.user_id, gm.joined_at, false
+  FROM group_members gm
+  JOIN group_seasons s ON s.id = $2::uuid AND s.status = 'DRAFT'
+  WHERE gm.group_id = $1::uuid AND gm.is_active
+)
+SELECT p.user_id, COALESCE(u.display_name, ''), COALESCE(u.avatar_url, ''), p.late, p.joined_at,
+       COALESCE(st.checkin_days, 0), COALESCE(st.last_date::text, ''), COALESCE(rn.run_days, 0),
+       COALESCE(pg.pages, 0), COALESCE(pg.minutes, 0),
+       COALESCE(lg.longest_days, 0), COALESCE(pg.best_pages, 0), COALESCE(pg.best_minutes, 0)
+FROM participants p
+JOIN users u ON u.id = p.user_id
+LEFT JOIN stats st ON st.user_id = p.user_id
+LEFT JOIN runs rn ON rn.user_id = p.user_id AND rn.run_end = st.last_date
+LEFT JOIN longest lg ON lg.user_id = p.user_id
+LEFT JOIN pages pg ON pg.user_id = p.user_id`
+
+	rows, err := db.Query(ctx, q, groupID, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list season participants: %w", err)
+	}
+	defer rows.Close()
+
+	out := []app.ParticipantRow{}
+	for rows.Next() {
+		var row app.ParticipantRow
+		p := &row.Stats
+		var lastDate string
+		if err := rows.Scan(&p.UserID, &row.DisplayName, &row.AvatarURL, &p.Late, &p.JoinedAt, &p.CheckinDays, &lastDate, &p.LastRunDays, &p.Pages, &p.Minutes,
+			&p.LongestStreak, &p.BestDayPages, &p.BestDayMinutes); err != nil {
+			return nil, fmt.Errorf("failed to scan season participant: %w", err)
+		}
+		p.LastCheckinDate = readingDomain.LocalDate(lastDate)
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+// InsertResults grava o snapshot; rodar de novo pra mesma season não muda nada.
+func (r *PostgresRepository) InsertResults(ctx context.Context, tx pgx.Tx, seasonID string, results []domainSeason.Result) error {
+	for _, res := range results {
+		var lastDate *string
+		if res.LastCheckinDate != "" {
+			d := res.LastCheckinDate.String()
+			lastDate = &d
+		}
+		_, err := tx.Exec(ctx, `
+INSERT INTO season_results (season_id, user_id, display_name, avatar_url, rank, score, final_streak, longest_streak, checkin_days, best_day_pages, best_day_minutes, last_checkin_date)
+VALUES ($1::uuid, $2::uuid, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12::date)
+ON CONFLICT (season_id, user_id) DO NOTHING`,
+			seasonID, res.UserID, res.DisplayName, res.AvatarURL, res.Rank, res.Score,
+			res.FinalStreak, res.LongestStreak, res.CheckinDays, res.BestDay.Pages, res.BestDay.Minutes, lastDate,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert season result: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PostgresRepository) ListResults(ctx context.Context, seasonID string) ([]domainSeason.Result, error) {
+	rows, err := r.pool.Query(ctx, `
+SELECT user_id, display_name, avatar_url, rank, score, final_streak, longest_streak, checkin_days, best_day_pages, best_day_minutes,
+       COALESCE(last_checkin_date::text, '')
+FROM season_results
+WHERE season_id = $1::uuid
+ORDER BY rank, user_id`, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list season results: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domainSeason.Result{}
+	for rows.Next() {
+		var res domainSeason.Result
+		var lastDate string
+		if err := rows.Scan(&res.UserID, &res.DisplayName, &res.AvatarURL, &res.Rank, &res.Score,
+			&res.FinalStreak, &res.LongestStreak, &res.CheckinDays, &res.BestDay.Pages, &res.BestDay.Minutes, &lastDate); err != nil {
+			return nil, fmt.Errorf("failed to scan season result: %w", err)
+		}
+		res.LastCheckinDate = readingDomain.LocalDate(lastDate)
+		out = append(out, res)
+	}
+	return out, rows.Err()
+}
+
+// SnapshotParticipants congela o roster na ativação: todo membro ativo, entrando em started_at.
+func (r *PostgresRepository) SnapshotParticipants(ctx context.Context, tx pgx.Tx, s *domainSeason.Season) error {
+	_, err := tx.Exec(ctx, `
+INSERT INTO season_participants (season_id, user_id, joined_at, late)
+SELECT $1::uuid, user_id, $3, false
+FROM group_members
+WHERE group_id = $2::uuid AND is_active
+ON CONFLICT (season_id, user_id) DO NOTHING`,
+		s.ID, s.GroupID, s.StartedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot season participants: %w", err)
+	}
+	return nil
+}
+
+// ReplaceTeams apaga a divisão anterior (membros caem em cascata) e grava a nova.
+func (r *PostgresRepository) ReplaceTeams(ctx context.Context, tx pgx.Tx, seasonID string, teams []domainSeason.Team) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM season_teams WHERE season_id = $1::uuid`, seasonID); err != nil {
+		return fmt.Errorf("failed to delete season teams: %w", err)
+	}
+
+	for _, t := range teams {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO season_teams (id, season_id, name, created_at) VALUES ($1::uuid, $2::uuid, $3, $4)`,
+			t.ID, seasonID, t.Name, t.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert season team: %w", err)
+		}
+		_, err = tx.Exec(ctx, `
+INSERT INTO season_team_members (season_id, team_id, user_id)
+SELECT $1::uuid, $2::uuid, unnest($3::uuid[])`,
+			seasonID, t.ID, t.MemberIDs,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert season team members: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PostgresRepository) ListTeams(ctx context.Context, seasonID string) ([]domainSeason.Team, error) {
+	return listTeams(ctx, r.pool, seasonID)
+}
+
+func (r *PostgresRepository) ListTeamsTx(ctx context.Context, tx pgx.Tx, seasonID string) ([]domainSeason.Team, error) {
+	return listTeams(ctx, tx, seasonID)
+}
+
+func listTeams(ctx context.Context, db querier, seasonID string) ([]domainSeason.Team, error) {
+	rows, err := db.Query(ctx, `
+SELECT t.id, t.season_id, t.name, t.created_at,
+       COALESCE(array_agg(m.user_id::text ORDER BY m.user_id) FILTER (WHERE m.user_id IS NOT NULL), '{}')
+FROM season_teams t
+LEFT JOIN season_team_members m ON m.team_id = t.id
+WHERE t.season_id = $1::uuid
+GROUP BY t.id
+ORDER BY t.name`, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list season teams: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domainSeason.Team{}
+	for rows.Next() {
+		var t domainSeason.Team
+		if err := rows.Scan(&t.ID, &t.SeasonID, &t.Name, &t.CreatedAt, &t.MemberIDs); err != nil {
+			return nil, fmt.Errorf("failed to scan season team: %w", err)
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
+
+func (r *PostgresRepository) ListActiveMemberIDs(ctx context.Context, tx pgx.Tx, groupID string) ([]string, error) {
+	rows, err := tx.Query(ctx,
+		`SELECT user_id::text FROM group_members WHERE group_id = $1::uuid AND is_active ORDER BY user_id`,
+		groupID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active members: %w", err)
+	}
+	defer rows.Close()
+
+	out := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan active member: %w", err)
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+// SaveTemplate faz upsert por group_id: o id e o created_at do template existente são mantidos.
+func (r *PostgresRepository) SaveTemplate(ctx context.Context, t *domainSeason.Template) error {
+	var periodDays *int
+	if t.Period == domainSeason.PeriodDays {
+		periodDays = &t.PeriodDays
+	}
+
+	err := r.pool.QueryRow(ctx, `
+INSERT INTO season_templates (id, group_id, metric, timezone, period, period_days, auto_activate,
+                              edit_window_minutes, min_pages, min_minutes, require_goal, late_join_policy,
+                              created_by_user_id, created_at, updated_at)
+VALUES ($1::uuid, $2::uuid, $3::group_metric, $4, $5::season_period, $6, $7,
+        $8, $9, $10, $11, $12::season_late_join_policy, $13::uuid, $14, $14)
+ON CONFLICT (group_id) DO UPDATE
+SET metric = EXCLUDED.metric,
+    timezone = EXCLUDED.timezone,
+    period = EXCLUDED.period,
+    period_days = EXCLUDED.period_days,
+    auto_activate = EXCLUDED.auto_activate,
+    edit_window_minutes = EXCLUDED.edit_window_minutes,
+    min_pages = EXCLUDED.min_pages,
+    min_minutes = EXCLUDED.min_minutes,
+    require_goal = EXCLUDED.require_goal,
+    late_join_policy = EXCLUDED.late_join_policy,
+    created_by_user_id = EXCLUDED.created_by_user_id
+RETURNING id, created_at, updated_at`,
+		t.ID, t.GroupID, t.Metric.String(), string(t.Timezone), t.Period.String(), periodDays, t.AutoActivate,
+		int(t.EditWindow/time.Minute), t.Qualification.MinPages, t.Qualification.MinMinutes, t.Qualification.RequireGoal, t.LateJoinPolicy.String(),
+		t.CreatedByUserID, t.CreatedAt,
+	).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save season template: %w", err)
+	}
+	return nil
+}
+
+const selectTemplate = `
+SELECT t.id, t.group_id, t.metric::text, t.timezone, t.period::text, COALESCE(t.period_days, 0), t.auto_activate,
+       t.edit_window_minutes, t.min_pages, t.min_minutes, t.require_goal, t.late_join_policy::text,
+       t.created_by_user_id, t.created_at, t.updated_at
+FROM season_templates t`
+
+func (r *PostgresRepository) FindTemplateByGroup(ctx context.Context, groupID string) (*domainSeason.Template, error) {
+	t, err := scanTemplate(r.pool.QueryRow(ctx, selectTemplate+` WHERE t.group_id = $1::uuid`, groupID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find season template: %w", err)
+	}
+	return t, nil
+}
+
+func (r *PostgresRepository) DeleteTemplate(ctx context.Context, groupID string) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM season_templates WHERE group_id = $1::uuid`, groupID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete season template: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *PostgresRepository) ListDueTemplateIDs(ctx context.Context) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+SELECT t.id
+FROM season_templates t
+JOIN groups g ON g.id = t.group_id AND g.archived_at IS NULL
+WHERE NOT EXISTS (
+        SELECT 1 FROM group_seasons s
+        WHERE s.group_id = t.group_id AND s.status IN ('DRAFT', 'ACTIVE'))
+  AND EXISTS (
+        SELECT 1 FROM group_seasons s
+        WHERE s.group_id = t.group_id AND s.status = 'ENDED')
+ORDER BY t.id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due season templates: %w", err)
+	}
+	defer rows.Close()
+
+	out := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan season template id: %w", err)
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+func (r *PostgresRepository) LockTemplate(ctx context.Context, tx pgx.Tx, templateID string) (*domainSeason.Template, error) {
+	t, err := scanTemplate(tx.QueryRow(ctx, selectTemplate+`
+JOIN groups g ON g.id = t.group_id AND g.archived_at IS NULL
+WHERE t.id = $1::uuid
+FOR UPDATE OF t`, templateID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock season template: %w", err)
+	}
+	return t, nil
+}
+
+func (r *PostgresRepository) HasOpenSeason(ctx context.Context, tx pgx.Tx, groupID string) (bool, error) {
+	var open bool
+	err := tx.QueryRow(ctx,
+		`SELECT EXISTS(SELECT 1 FROM group_seasons WHERE group_id = $1::uuid AND status IN ('DRAFT', 'ACTIVE'))`,
+		groupID,
+	).Scan(&open)
+	if err != nil {
+		return false, fmt.Errorf("failed to check open season: %w", err)
+	}
+	return open, nil
+}
+
+func (r *PostgresRepository) LastEndedAt(ctx context.Context, tx pgx.Tx, groupID string) (*time.Time, error) {
+	var endsAt *time.Time
+	err := tx.QueryRow(ctx,
+		`SELECT MAX(ends_at) FROM group_seasons WHERE group_id = $1::uuid AND status = 'ENDED'`,
+		groupID,
+	).Scan(&endsAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find last ended season: %w", err)
+	}
+	return endsAt, nil
+}
+
+func (r *PostgresRepository) InsertFromTemplate(ctx context.Context, tx pgx.Tx, s *domainSeason.Season, templateID string, periodStart time.Time) (bool, error) {
+	tag, err := tx.Exec(ctx,
+		`INSERT INTO group_seasons (id, group_id, status, started_at, ends_at, timezone, metric, edit_window_minutes, min_pages, min_minutes, require_goal, late_join_policy, created_by_user_id, created_at, updated_at, template_id, period_start)
+		 VALUES ($1, $2, $3::group_season_status, $4, $5, $6, $7::group_metric, $8, $9, $10, $11, $12::season_late_join_policy, $13, $14, $15, $16::uuid, $17)
+		 ON CONFLICT (template_id, period_start) WHERE template_id IS NOT NULL DO NOTHING`,
+		s.ID,
+		s.GroupID,
+		s.Status.String(),
+		s.StartedAt,
+		s.EndsAt,
+		string(s.Timezone),
+		s.Metric.String(),
+		int(s.EditWindow/time.Minute),
+		s.Qualification.MinPages,
+		s.Qualification.MinMinutes,
+		s.Qualification.RequireGoal,
+		s.LateJoinPolicy.String(),
+		s.CreatedByUserID,
+		s.CreatedAt,
+		s.UpdatedAt,
+		templateID,
+		periodStart,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_group_seasons_one_active_per_group" {
+		return false, domainSeason.ErrActiveSeasonExists
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to insert season from template: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func scanTemplate(row pgx.Row) (*domainSeason.Template, error) {
+	var t domainSeason.Template
+	var metric, timezone, period, lateJoin string
+	var editWindow int
+	err := row.Scan(&t.ID, &t.GroupID, &metric, &timezone, &period, &t.PeriodDays, &t.AutoActivate,
+		&editWindow, &t.Qualification.MinPages, &t.Qualification.MinMinutes, &t.Qualification.RequireGoal, &lateJoin,
+		&t.CreatedByUserID, &t.CreatedAt, &t.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t.Metric = domainSeason.Metric(metric)
+	t.Timezone = domainSeason.Timezone(timezone)
+	t.Period = domainSeason.Period(period)
+	t.EditWindow = time.Duration(editWindow) * time.Minute
+	t.LateJoinPolicy = domainSeason.LateJoinPolicy(lateJoin)
+	return &t, nil
+}
+
+func scanSeason(row pgx.Row) (*domainSeason.Season, error) {
+	var s domainSeason.Season
+	var status, timezone, metric, lateJoin string
+	var editWindow int
+	err := row.Scan(&s.ID, &s.GroupID, &status, &s.StartedAt, &s.EndsAt, &timezone, &metric, &editWindow,
+		&s.Qualification.MinPages, &s.Qualification.MinMinutes, &s.Qualification.RequireGoal, &lateJoin, &s.CreatedByUserID, &s.CreatedAt, &s.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.Status = domainSeason.Status(status)
+	s.Timezone = domainSeason.Timezone(timezone)
+	s.Metric = domainSeason.Metric(metric)
+	s.EditWindow = time.Duration(editWindow) * time.Minute
+	s.LateJoinPolicy = domainSeason.LateJoinPolicy(lateJoin)
+	return &s, nil
+}
@@ -0,0 +1,26 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildAcceptInviteInput(event events.APIGatewayV2HTTPRequest) (appGroup.AcceptInviteInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.AcceptInviteInput{}, err
+	}
+
+	code, err := domainGroup.NewInviteCode(event.PathParameters["code"])
+	if err != nil {
+		return appGroup.AcceptInviteInput{}, err
+	}
+
+	return appGroup.AcceptInviteInput{
+		Claims: claims,
+		Code:   code,
+	}, nil
+}
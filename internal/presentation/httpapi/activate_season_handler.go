@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type ActivateSeasonHandler struct {
+	uc *appSeason.ActivateSeasonUseCase
+}
+
+func NewActivateSeasonHandler(uc *appSeason.ActivateSeasonUseCase) *ActivateSeasonHandler {
+	return &ActivateSeasonHandler{uc: uc}
+}
+
+func (h *ActivateSeasonHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildActivateSeasonInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return seasonErrorResponse(event, "ActivateSeason", err), nil
+	}
+
+	return JSON(http.StatusOK, out), nil
+}
@@ -0,0 +1,31 @@
+package httpapi
+
+import (
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildActivateSeasonInput(event events.APIGatewayV2HTTPRequest) (appSeason.ActivateSeasonInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appSeason.ActivateSeasonInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appSeason.ActivateSeasonInput{}, err
+	}
+
+	seasonID, err := uuidPathParam(event, "seasonId", "season_id")
+	if err != nil {
+		return appSeason.ActivateSeasonInput{}, err
+	}
+
+	return appSeason.ActivateSeasonInput{
+		Claims:   claims,
+		GroupID:  groupID,
+		SeasonID: seasonID,
+	}, nil
+}
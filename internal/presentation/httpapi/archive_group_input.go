@@ -0,0 +1,25 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildArchiveGroupInput(event events.APIGatewayV2HTTPRequest) (appGroup.ArchiveGroupInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.ArchiveGroupInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.ArchiveGroupInput{}, err
+	}
+
+	return appGroup.ArchiveGroupInput{
+		Claims:  claims,
+		GroupID: groupID,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type AssignTeamsHandler struct {
+	uc *appSeason.AssignTeamsUseCase
+}
+
+func NewAssignTeamsHandler(uc *appSeason.AssignTeamsUseCase) *AssignTeamsHandler {
+	return &AssignTeamsHandler{uc: uc}
+}
+
+func (h *AssignTeamsHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildAssignTeamsInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return seasonErrorResponse(event, "AssignTeams", err), nil
+	}
+
+	return JSON(http.StatusOK, out), nil
+}
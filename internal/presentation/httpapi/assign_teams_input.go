@@ -0,0 +1,59 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// com balance=true os times vêm só com nome e o servidor sorteia os membros
+type assignTeamsBody struct {
+	Teams []struct {
+		Name      string   `json:"name"`
+		MemberIDs []string `json:"member_ids"`
+	} `json:"teams"`
+	Balance bool `json:"balance"`
+}
+
+func BuildAssignTeamsInput(event events.APIGatewayV2HTTPRequest) (appSeason.AssignTeamsInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appSeason.AssignTeamsInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appSeason.AssignTeamsInput{}, err
+	}
+
+	seasonID, err := uuidPathParam(event, "seasonId", "season_id")
+	if err != nil {
+		return appSeason.AssignTeamsInput{}, err
+	}
+
+	// Parse body
+	var body assignTeamsBody
+	if err := json.Unmarshal([]byte(event.Body), &body); err != nil {
+		return appSeason.AssignTeamsInput{}, errors.New("invalid request body")
+	}
+
+	teams := make([]appSeason.TeamInput, 0, len(body.Teams))
+	for _, t := range body.Teams {
+		if body.Balance && len(t.MemberIDs) > 0 {
+			return appSeason.AssignTeamsInput{}, errors.New("member_ids must be empty when balance is true")
+		}
+		teams = append(teams, appSeason.TeamInput{Name: t.Name, MemberIDs: t.MemberIDs})
+	}
+
+	return appSeason.AssignTeamsInput{
+		Claims:   claims,
+		GroupID:  groupID,
+		SeasonID: seasonID,
+		Teams:    teams,
+		Balance:  body.Balance,
+	}, nil
+}
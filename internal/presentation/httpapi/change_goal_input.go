@@ -11,8 +11,10 @@ import (
 	"github.com/aws/aws-lambda-go/events"
 )
 
+// meta em páginas, em minutos ou nas duas
 type changeGoalBody struct {
-	Pages int `json:"pages"`
+	Pages   int `json:"pages"`
+	Minutes int `json:"minutes"`
 }
 
 func BuildChangeGoalInput(event events.APIGatewayV2HTTPRequest) (app.ChangeGoalInput, error) {
@@ -32,13 +34,13 @@ func BuildChangeGoalInput(event events.APIGatewayV2HTTPRequest) (app.ChangeGoalI
 		return app.ChangeGoalInput{}, errors.New("invalid json")
 	}
 
-	pages, err := readingDomain.NewPages(body.Pages)
+	goal, err := readingDomain.NewDailyGoal(body.Pages, body.Minutes)
 	if err != nil {
 		return app.ChangeGoalInput{}, err
 	}
 
 	return app.ChangeGoalInput{
 		Claims: claims,
-		Pages:  pages,
+		Goal:   goal,
 	}, nil
 }
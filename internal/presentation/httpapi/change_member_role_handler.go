@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type ChangeMemberRoleHandler struct {
+	uc *appGroup.ChangeMemberRoleUseCase
+}
+
+func NewChangeMemberRoleHandler(uc *appGroup.ChangeMemberRoleUseCase) *ChangeMemberRoleHandler {
+	return &ChangeMemberRoleHandler{uc: uc}
+}
+
+func (h *ChangeMemberRoleHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildChangeMemberRoleInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return groupErrorResponse(event, "ChangeMemberRole", err), nil
+	}
+
+	return JSON(http.StatusOK, out), nil
+}
@@ -0,0 +1,51 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+
+	appGroup "reading-cats-api/internal/application/group"
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type changeMemberRoleBody struct {
+	Role string `json:"role"`
+}
+
+func BuildChangeMemberRoleInput(event events.APIGatewayV2HTTPRequest) (appGroup.ChangeMemberRoleInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.ChangeMemberRoleInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.ChangeMemberRoleInput{}, err
+	}
+
+	userID, err := uuidPathParam(event, "userId", "user_id")
+	if err != nil {
+		return appGroup.ChangeMemberRoleInput{}, err
+	}
+
+	// Parse body
+	var body changeMemberRoleBody
+	if err := json.Unmarshal([]byte(event.Body), &body); err != nil {
+		return appGroup.ChangeMemberRoleInput{}, errors.New("invalid request body")
+	}
+
+	role, err := domainGroup.NewRole(body.Role)
+	if err != nil {
+		return appGroup.ChangeMemberRoleInput{}, err
+	}
+
+	return appGroup.ChangeMemberRoleInput{
+		Claims:  claims,
+		GroupID: groupID,
+		UserID:  userID,
+		Role:    role,
+	}, nil
+}
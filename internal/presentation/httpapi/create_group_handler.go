@@ -2,7 +2,6 @@ package httpapi
 
 import (
 	"context"
-	"log"
 	"net/http"
 
 	appGroup "reading-cats-api/internal/application/group"
@@ -29,8 +28,7 @@ func (h *CreateGroupHandler) Handle(ctx context.Context, event events.APIGateway
 
 	out, err := h.uc.Execute(ctx, in)
 	if err != nil {
-		log.Printf("[httpapi] CreateGroup error: %v", err)
-		return Error(event, http.StatusInternalServerError, err.Error()), nil
+		return groupErrorResponse(event, "CreateGroup", err), nil
 	}
 
 	return JSON(http.StatusCreated, out), nil
@@ -13,6 +13,7 @@ type createGroupBody struct {
 	Name       string `json:"name"`
 	IconID     string `json:"icon_id"`
 	MaxMembers *int   `json:"max_members,omitempty"`
+	Visibility string `json:"visibility,omitempty"`
 }
 
 func BuildCreateGroupInput(event events.APIGatewayV2HTTPRequest) (appGroup.CreateGroupInput, error) {
@@ -37,5 +38,6 @@ func BuildCreateGroupInput(event events.APIGatewayV2HTTPRequest) (appGroup.Creat
 		Name:       body.Name,
 		IconID:     body.IconID,
 		MaxMembers: body.MaxMembers,
+		Visibility: body.Visibility,
 	}, nil
 }
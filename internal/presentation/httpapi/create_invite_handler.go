@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type CreateInviteHandler struct {
+	uc *appGroup.CreateInviteUseCase
+}
+
+func NewCreateInviteHandler(uc *appGroup.CreateInviteUseCase) *CreateInviteHandler {
+	return &CreateInviteHandler{uc: uc}
+}
+
+func (h *CreateInviteHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildCreateInviteInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return groupErrorResponse(event, "CreateInvite", err), nil
+	}
+
+	return JSON(http.StatusCreated, out), nil
+}
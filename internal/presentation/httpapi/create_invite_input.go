@@ -0,0 +1,44 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type createInviteBody struct {
+	ExpiresInHours *int `json:"expires_in_hours,omitempty"`
+	SingleUse      bool `json:"single_use"`
+}
+
+func BuildCreateInviteInput(event events.APIGatewayV2HTTPRequest) (appGroup.CreateInviteInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.CreateInviteInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.CreateInviteInput{}, err
+	}
+
+	// Body é opcional: sem body = convite padrão (72h, múltiplos usos)
+	var body createInviteBody
+	if s := strings.TrimSpace(event.Body); s != "" {
+		if err := json.Unmarshal([]byte(s), &body); err != nil {
+			return appGroup.CreateInviteInput{}, errors.New("invalid request body")
+		}
+	}
+
+	return appGroup.CreateInviteInput{
+		Claims:         claims,
+		GroupID:        groupID,
+		ExpiresInHours: body.ExpiresInHours,
+		SingleUse:      body.SingleUse,
+	}, nil
+}
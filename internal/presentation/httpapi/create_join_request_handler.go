@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type CreateJoinRequestHandler struct {
+	uc *appGroup.CreateJoinRequestUseCase
+}
+
+func NewCreateJoinRequestHandler(uc *appGroup.CreateJoinRequestUseCase) *CreateJoinRequestHandler {
+	return &CreateJoinRequestHandler{uc: uc}
+}
+
+func (h *CreateJoinRequestHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildCreateJoinRequestInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return groupErrorResponse(event, "CreateJoinRequest", err), nil
+	}
+
+	return JSON(http.StatusCreated, out), nil
+}
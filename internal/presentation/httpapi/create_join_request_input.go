@@ -0,0 +1,25 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildCreateJoinRequestInput(event events.APIGatewayV2HTTPRequest) (appGroup.CreateJoinRequestInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.CreateJoinRequestInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.CreateJoinRequestInput{}, err
+	}
+
+	return appGroup.CreateJoinRequestInput{
+		Claims:  claims,
+		GroupID: groupID,
+	}, nil
+}
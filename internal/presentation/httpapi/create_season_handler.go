@@ -2,7 +2,6 @@ package httpapi
 
 import (
 	"context"
-	"log"
 	"net/http"
 
 	appSeason "reading-cats-api/internal/application/season"
@@ -29,8 +28,7 @@ func (h *CreateSeasonHandler) Handle(ctx context.Context, event events.APIGatewa
 
 	out, err := h.uc.Execute(ctx, in)
 	if err != nil {
-		log.Printf("[httpapi] CreateSeason error: %v", err)
-		return Error(event, http.StatusInternalServerError, err.Error()), nil
+		return seasonErrorResponse(event, "CreateSeason", err), nil
 	}
 
 	return JSON(http.StatusCreated, out), nil
@@ -3,7 +3,6 @@ package httpapi
 import (
 	"encoding/json"
 	"errors"
-	"strings"
 
 	appSeason "reading-cats-api/internal/application/season"
 
@@ -13,6 +12,15 @@ import (
 type createSeasonBody struct {
 	EndsAt   *string `json:"ends_at,omitempty"`
 	Timezone string  `json:"timezone"`
+	Metric   string  `json:"metric,omitempty"`
+	// edit_window_minutes: por quanto tempo o membro ainda corrige o check-in (padrão 15)
+	EditWindowMinutes *int `json:"edit_window_minutes,omitempty"`
+	// mínimos pro dia contar na season; require_goal exige a meta pessoal do membro
+	MinPages    int  `json:"min_pages,omitempty"`
+	MinMinutes  int  `json:"min_minutes,omitempty"`
+	RequireGoal bool `json:"require_goal,omitempty"`
+	// late_join_policy: EXCLUDED, FROM_JOIN_DATE (padrão) ou PRORATED
+	LateJoinPolicy string `json:"late_join_policy,omitempty"`
 }
 
 func BuildCreateSeasonInput(event events.APIGatewayV2HTTPRequest) (appSeason.CreateSeasonInput, error) {
@@ -23,9 +31,9 @@ func BuildCreateSeasonInput(event events.APIGatewayV2HTTPRequest) (appSeason.Cre
 	}
 
 	// Extract groupID from path: /v1/groups/{groupId}/seasons
-	groupID := extractGroupIDFromPath(event.RawPath)
-	if groupID == "" {
-		return appSeason.CreateSeasonInput{}, errors.New("invalid group_id in path")
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appSeason.CreateSeasonInput{}, err
 	}
 
 	// Parse body
@@ -43,14 +51,12 @@ func BuildCreateSeasonInput(event events.APIGatewayV2HTTPRequest) (appSeason.Cre
 		GroupID:  groupID,
 		EndsAt:   body.EndsAt,
 		Timezone: body.Timezone,
-	}, nil
-}
+		Metric:   body.Metric,
 
-func extractGroupIDFromPath(path string) string {
-	// Path format: /v1/groups/{groupId}/seasons
-	parts := strings.Split(strings.Trim(path, "/"), "/")
-	if len(parts) >= 3 && parts[0] == "v1" && parts[1] == "groups" {
-		return parts[2]
-	}
-	return ""
+		EditWindowMinutes: body.EditWindowMinutes,
+		MinPages:          body.MinPages,
+		MinMinutes:        body.MinMinutes,
+		RequireGoal:       body.RequireGoal,
+		LateJoinPolicy:    body.LateJoinPolicy,
+	}, nil
 }
@@ -0,0 +1,44 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// DecideJoinRequestHandler atende as duas rotas de decisão (approve/reject) com o mesmo use case.
+type DecideJoinRequestHandler struct {
+	uc *appGroup.DecideJoinRequestUseCase
+}
+
+func NewDecideJoinRequestHandler(uc *appGroup.DecideJoinRequestUseCase) *DecideJoinRequestHandler {
+	return &DecideJoinRequestHandler{uc: uc}
+}
+
+func (h *DecideJoinRequestHandler) Approve(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	return h.handle(ctx, event, true)
+}
+
+func (h *DecideJoinRequestHandler) Reject(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	return h.handle(ctx, event, false)
+}
+
+func (h *DecideJoinRequestHandler) handle(ctx context.Context, event events.APIGatewayV2HTTPRequest, approve bool) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildDecideJoinRequestInput(event, approve)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return groupErrorResponse(event, "DecideJoinRequest", err), nil
+	}
+
+	return JSON(http.StatusOK, out), nil
+}
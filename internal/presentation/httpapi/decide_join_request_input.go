@@ -0,0 +1,32 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildDecideJoinRequestInput(event events.APIGatewayV2HTTPRequest, approve bool) (appGroup.DecideJoinRequestInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.DecideJoinRequestInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.DecideJoinRequestInput{}, err
+	}
+
+	requestID, err := uuidPathParam(event, "requestId", "request_id")
+	if err != nil {
+		return appGroup.DecideJoinRequestInput{}, err
+	}
+
+	return appGroup.DecideJoinRequestInput{
+		Claims:    claims,
+		GroupID:   groupID,
+		RequestID: requestID,
+		Approve:   approve,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildDeleteGroupInput(event events.APIGatewayV2HTTPRequest) (appGroup.DeleteGroupInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.DeleteGroupInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.DeleteGroupInput{}, err
+	}
+
+	return appGroup.DeleteGroupInput{
+		Claims:  claims,
+		GroupID: groupID,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	app "reading-cats-api/internal/application/reading"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type DeleteReadingHandler struct {
+	uc *app.DeleteReadingUseCase
+}
+
+func NewDeleteReadingHandler(uc *app.DeleteReadingUseCase) *DeleteReadingHandler {
+	return &DeleteReadingHandler{uc: uc}
+}
+
+func (h *DeleteReadingHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildDeleteReadingInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	if err := h.uc.Execute(ctx, in); err != nil {
+		return readingErrorResponse(event, "DeleteReading", err), nil
+	}
+
+	return NoContent(), nil
+}
@@ -0,0 +1,27 @@
+package httpapi
+
+import (
+	app "reading-cats-api/internal/application/reading"
+	readingDomain "reading-cats-api/internal/domain/reading"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildDeleteReadingInput(event events.APIGatewayV2HTTPRequest) (app.DeleteReadingInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return app.DeleteReadingInput{}, err
+	}
+
+	// Extract date from path: /v1/reading/logs/{date}
+	date, err := readingDomain.ParseLocalDate(event.PathParameters["date"])
+	if err != nil {
+		return app.DeleteReadingInput{}, err
+	}
+
+	return app.DeleteReadingInput{
+		Claims: claims,
+		Date:   date,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type DeleteSeasonTemplateHandler struct {
+	uc *appSeason.DeleteSeasonTemplateUseCase
+}
+
+func NewDeleteSeasonTemplateHandler(uc *appSeason.DeleteSeasonTemplateUseCase) *DeleteSeasonTemplateHandler {
+	return &DeleteSeasonTemplateHandler{uc: uc}
+}
+
+func (h *DeleteSeasonTemplateHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildSeasonTemplateInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	if err := h.uc.Execute(ctx, in); err != nil {
+		return seasonErrorResponse(event, "DeleteSeasonTemplate", err), nil
+	}
+
+	return NoContent(), nil
+}
@@ -0,0 +1,39 @@
+package httpapi
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// o nome do grupo tem no máximo 30 caracteres; buscar por mais que isso não casa nada
+const maxDiscoverQueryLength = 30
+
+func BuildDiscoverGroupsInput(event events.APIGatewayV2HTTPRequest) (appGroup.DiscoverGroupsInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.DiscoverGroupsInput{}, err
+	}
+
+	query := strings.TrimSpace(event.QueryStringParameters["q"])
+	if utf8.RuneCountInString(query) > maxDiscoverQueryLength {
+		return appGroup.DiscoverGroupsInput{}, errors.New("invalid q: must be at most 30 characters")
+	}
+
+	limit, err := parseLimit(event.QueryStringParameters["limit"])
+	if err != nil {
+		return appGroup.DiscoverGroupsInput{}, err
+	}
+
+	return appGroup.DiscoverGroupsInput{
+		Claims: claims,
+		Query:  query,
+		Cursor: event.QueryStringParameters["cursor"],
+		Limit:  limit,
+	}, nil
+}
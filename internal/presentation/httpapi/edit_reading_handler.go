@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	app "reading-cats-api/internal/application/reading"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type EditReadingHandler struct {
+	uc *app.EditReadingUseCase
+}
+
+func NewEditReadingHandler(uc *app.EditReadingUseCase) *EditReadingHandler {
+	return &EditReadingHandler{uc: uc}
+}
+
+func (h *EditReadingHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildEditReadingInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return readingErrorResponse(event, "EditReading", err), nil
+	}
+
+	return JSON(http.StatusOK, map[string]any{"day": out}), nil
+}
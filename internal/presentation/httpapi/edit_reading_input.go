@@ -0,0 +1,50 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+
+	app "reading-cats-api/internal/application/reading"
+	readingDomain "reading-cats-api/internal/domain/reading"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// os valores substituem os totais do dia (não somam como no POST)
+type editReadingBody struct {
+	Pages   int `json:"pages"`
+	Minutes int `json:"minutes"`
+}
+
+func BuildEditReadingInput(event events.APIGatewayV2HTTPRequest) (app.EditReadingInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return app.EditReadingInput{}, err
+	}
+
+	// Extract date from path: /v1/reading/logs/{date}
+	date, err := readingDomain.ParseLocalDate(event.PathParameters["date"])
+	if err != nil {
+		return app.EditReadingInput{}, err
+	}
+
+	// Parse body
+	var body editReadingBody
+	if err := json.Unmarshal([]byte(event.Body), &body); err != nil {
+		return app.EditReadingInput{}, errors.New("invalid request body")
+	}
+
+	// totais do dia podem passar do limite de um registro; o teto é o do dia
+	pagesVO, minutesVO, err := readingDomain.NewDayTotals(body.Pages, body.Minutes)
+	if err != nil {
+		return app.EditReadingInput{}, err
+	}
+
+	return app.EditReadingInput{
+		Claims:  claims,
+		Date:    date,
+		Pages:   pagesVO,
+		Minutes: minutesVO,
+	}, nil
+}
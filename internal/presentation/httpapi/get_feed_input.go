@@ -0,0 +1,32 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildGetFeedInput(event events.APIGatewayV2HTTPRequest) (appGroup.GetFeedInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.GetFeedInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.GetFeedInput{}, err
+	}
+
+	limit, err := parseLimit(event.QueryStringParameters["limit"])
+	if err != nil {
+		return appGroup.GetFeedInput{}, err
+	}
+
+	return appGroup.GetFeedInput{
+		Claims:  claims,
+		GroupID: groupID,
+		Cursor:  event.QueryStringParameters["cursor"],
+		Limit:   limit,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetGroupHandler struct {
+	uc *appGroup.GetGroupUseCase
+}
+
+func NewGetGroupHandler(uc *appGroup.GetGroupUseCase) *GetGroupHandler {
+	return &GetGroupHandler{uc: uc}
+}
+
+func (h *GetGroupHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildGetGroupInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return groupErrorResponse(event, "GetGroup", err), nil
+	}
+
+	return JSON(http.StatusOK, out), nil
+}
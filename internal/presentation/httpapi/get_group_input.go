@@ -0,0 +1,25 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildGetGroupInput(event events.APIGatewayV2HTTPRequest) (appGroup.GetGroupInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.GetGroupInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.GetGroupInput{}, err
+	}
+
+	return appGroup.GetGroupInput{
+		Claims:  claims,
+		GroupID: groupID,
+	}, nil
+}
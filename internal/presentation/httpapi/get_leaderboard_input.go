@@ -0,0 +1,31 @@
+package httpapi
+
+import (
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildGetLeaderboardInput(event events.APIGatewayV2HTTPRequest) (appSeason.GetLeaderboardInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appSeason.GetLeaderboardInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appSeason.GetLeaderboardInput{}, err
+	}
+
+	seasonID, err := uuidPathParam(event, "seasonId", "season_id")
+	if err != nil {
+		return appSeason.GetLeaderboardInput{}, err
+	}
+
+	return appSeason.GetLeaderboardInput{
+		Claims:   claims,
+		GroupID:  groupID,
+		SeasonID: seasonID,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package httpapi
+
+import (
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildGetSeasonResultsInput(event events.APIGatewayV2HTTPRequest) (appSeason.GetSeasonResultsInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appSeason.GetSeasonResultsInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appSeason.GetSeasonResultsInput{}, err
+	}
+
+	seasonID, err := uuidPathParam(event, "seasonId", "season_id")
+	if err != nil {
+		return appSeason.GetSeasonResultsInput{}, err
+	}
+
+	return appSeason.GetSeasonResultsInput{
+		Claims:   claims,
+		GroupID:  groupID,
+		SeasonID: seasonID,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetTeamLeaderboardHandler struct {
+	uc *appSeason.GetTeamLeaderboardUseCase
+}
+
+func NewGetTeamLeaderboardHandler(uc *appSeason.GetTeamLeaderboardUseCase) *GetTeamLeaderboardHandler {
+	return &GetTeamLeaderboardHandler{uc: uc}
+}
+
+// Handle usa a mesma entrada do leaderboard individual (grupo + season).
+func (h *GetTeamLeaderboardHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildGetLeaderboardInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return seasonErrorResponse(event, "GetTeamLeaderboard", err), nil
+	}
+
+	return JSON(http.StatusOK, out), nil
+}
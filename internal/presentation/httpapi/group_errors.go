@@ -0,0 +1,54 @@
+package httpapi
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+	"reading-cats-api/internal/application/pagination"
+	domainGroup "reading-cats-api/internal/domain/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// groupErrorResponse traduz os erros dos use cases de grupo em respostas HTTP.
+// Erros desconhecidos viram 500 e são logados com o nome da operação.
+func groupErrorResponse(event events.APIGatewayV2HTTPRequest, op string, err error) events.APIGatewayV2HTTPResponse {
+	switch {
+	case errors.Is(err, appGroup.ErrUserNotFound):
+		return Error(event, http.StatusNotFound, "user not found")
+	case errors.Is(err, appGroup.ErrGroupNotFound):
+		return Error(event, http.StatusNotFound, "group not found")
+	case errors.Is(err, appGroup.ErrInviteNotFound),
+		errors.Is(err, appGroup.ErrMemberNotFound),
+		errors.Is(err, appGroup.ErrJoinRequestNotFound):
+		return Error(event, http.StatusNotFound, err.Error())
+	case errors.Is(err, appGroup.ErrForbidden):
+		return Error(event, http.StatusForbidden, err.Error())
+	case errors.Is(err, domainGroup.ErrInviteExpired),
+		errors.Is(err, domainGroup.ErrInviteAlreadyUsed):
+		return Error(event, http.StatusGone, err.Error())
+	case errors.Is(err, domainGroup.ErrGroupFull),
+		errors.Is(err, domainGroup.ErrLastAdmin),
+		errors.Is(err, domainGroup.ErrOwnerMustBeAdmin),
+		errors.Is(err, domainGroup.ErrCannotRemoveOwner),
+		errors.Is(err, domainGroup.ErrMaxMembersTooLow),
+		errors.Is(err, domainGroup.ErrGroupArchived),
+		errors.Is(err, domainGroup.ErrJoinRequestNotPending),
+		errors.Is(err, appGroup.ErrAlreadyMember),
+		errors.Is(err, appGroup.ErrJoinRequestExists):
+		return Error(event, http.StatusConflict, err.Error())
+	case errors.Is(err, pagination.ErrInvalidCursor),
+		errors.Is(err, domainGroup.ErrInvalidGroupName),
+		errors.Is(err, domainGroup.ErrInvalidIconID),
+		errors.Is(err, domainGroup.ErrInvalidMaxMembers),
+		errors.Is(err, domainGroup.ErrInvalidVisibility),
+		errors.Is(err, domainGroup.ErrInvalidInviteTTL),
+		errors.Is(err, appGroup.ErrCannotRemoveSelf):
+		return Error(event, http.StatusBadRequest, err.Error())
+	}
+
+	log.Printf("[httpapi] %s error: %v", op, err)
+	return Error(event, http.StatusInternalServerError, "internal error")
+}
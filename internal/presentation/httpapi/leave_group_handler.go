@@ -0,0 +1,34 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type LeaveGroupHandler struct {
+	uc *appGroup.LeaveGroupUseCase
+}
+
+func NewLeaveGroupHandler(uc *appGroup.LeaveGroupUseCase) *LeaveGroupHandler {
+	return &LeaveGroupHandler{uc: uc}
+}
+
+func (h *LeaveGroupHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildLeaveGroupInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	if err := h.uc.Execute(ctx, in); err != nil {
+		return groupErrorResponse(event, "LeaveGroup", err), nil
+	}
+
+	return NoContent(), nil
+}
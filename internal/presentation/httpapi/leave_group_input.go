@@ -0,0 +1,25 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildLeaveGroupInput(event events.APIGatewayV2HTTPRequest) (appGroup.LeaveGroupInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.LeaveGroupInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.LeaveGroupInput{}, err
+	}
+
+	return appGroup.LeaveGroupInput{
+		Claims:  claims,
+		GroupID: groupID,
+	}, nil
+}
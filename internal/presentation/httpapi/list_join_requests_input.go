@@ -0,0 +1,25 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildListJoinRequestsInput(event events.APIGatewayV2HTTPRequest) (appGroup.ListJoinRequestsInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.ListJoinRequestsInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.ListJoinRequestsInput{}, err
+	}
+
+	return appGroup.ListJoinRequestsInput{
+		Claims:  claims,
+		GroupID: groupID,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type ListMyGroupsHandler struct {
+	uc *appGroup.ListMyGroupsUseCase
+}
+
+func NewListMyGroupsHandler(uc *appGroup.ListMyGroupsUseCase) *ListMyGroupsHandler {
+	return &ListMyGroupsHandler{uc: uc}
+}
+
+func (h *ListMyGroupsHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildListMyGroupsInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return groupErrorResponse(event, "ListMyGroups", err), nil
+	}
+
+	return JSON(http.StatusOK, out), nil
+}
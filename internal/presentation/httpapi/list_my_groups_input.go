@@ -0,0 +1,41 @@
+package httpapi
+
+import (
+	"errors"
+	"strconv"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildListMyGroupsInput(event events.APIGatewayV2HTTPRequest) (appGroup.ListMyGroupsInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.ListMyGroupsInput{}, err
+	}
+
+	limit, err := parseLimit(event.QueryStringParameters["limit"])
+	if err != nil {
+		return appGroup.ListMyGroupsInput{}, err
+	}
+
+	return appGroup.ListMyGroupsInput{
+		Claims: claims,
+		Cursor: event.QueryStringParameters["cursor"],
+		Limit:  limit,
+	}, nil
+}
+
+// parseLimit lê o query param "limit"; vazio significa "use o default".
+func parseLimit(v string) (int, error) {
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, errors.New("invalid limit")
+	}
+	return n, nil
+}
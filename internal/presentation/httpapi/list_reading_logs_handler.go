@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	app "reading-cats-api/internal/application/reading"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type ListReadingLogsHandler struct {
+	uc *app.ListReadingLogsUseCase
+}
+
+func NewListReadingLogsHandler(uc *app.ListReadingLogsUseCase) *ListReadingLogsHandler {
+	return &ListReadingLogsHandler{uc: uc}
+}
+
+func (h *ListReadingLogsHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildListReadingLogsInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return readingErrorResponse(event, "ListReadingLogs", err), nil
+	}
+
+	return JSON(http.StatusOK, out), nil
+}
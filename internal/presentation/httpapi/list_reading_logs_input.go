@@ -0,0 +1,30 @@
+package httpapi
+
+import (
+	app "reading-cats-api/internal/application/reading"
+	readingDomain "reading-cats-api/internal/domain/reading"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildListReadingLogsInput(event events.APIGatewayV2HTTPRequest) (app.ListReadingLogsInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return app.ListReadingLogsInput{}, err
+	}
+
+	// ?date=YYYY-MM-DD é opcional
+	var date readingDomain.LocalDate
+	if v := event.QueryStringParameters["date"]; v != "" {
+		date, err = readingDomain.ParseLocalDate(v)
+		if err != nil {
+			return app.ListReadingLogsInput{}, err
+		}
+	}
+
+	return app.ListReadingLogsInput{
+		Claims: claims,
+		Date:   date,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package httpapi
+
+import (
+	appSeason "reading-cats-api/internal/application/season"
+	domainSeason "reading-cats-api/internal/domain/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildListSeasonsInput(event events.APIGatewayV2HTTPRequest) (appSeason.ListSeasonsInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appSeason.ListSeasonsInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appSeason.ListSeasonsInput{}, err
+	}
+
+	var status domainSeason.Status
+	if v := event.QueryStringParameters["status"]; v != "" {
+		status, err = domainSeason.NewStatus(v)
+		if err != nil {
+			return appSeason.ListSeasonsInput{}, err
+		}
+	}
+
+	limit, err := parseLimit(event.QueryStringParameters["limit"])
+	if err != nil {
+		return appSeason.ListSeasonsInput{}, err
+	}
+
+	return appSeason.ListSeasonsInput{
+		Claims:  claims,
+		GroupID: groupID,
+		Status:  status,
+		Cursor:  event.QueryStringParameters["cursor"],
+		Limit:   limit,
+	}, nil
+}
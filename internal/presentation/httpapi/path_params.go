@@ -0,0 +1,44 @@
+package httpapi
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/google/uuid"
+)
+
+// matchPath compara o path com um padrão como "/v1/groups/{groupId}/seasons"
+// e devolve os valores capturados pelos {placeholders}.
+func matchPath(pattern, path string) (map[string]string, bool) {
+	patternParts := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternParts) != len(pathParts) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, p := range patternParts {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			if pathParts[i] == "" {
+				return nil, false
+			}
+			params[strings.Trim(p, "{}")] = pathParts[i]
+			continue
+		}
+		if p != pathParts[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// uuidPathParam lê um parâmetro de path preenchido pelo Router e valida que é um UUID,
+// pra que um ID mal formado vire 400 em vez de erro de cast no Postgres.
+func uuidPathParam(event events.APIGatewayV2HTTPRequest, name, field string) (string, error) {
+	v := event.PathParameters[name]
+	if err := uuid.Validate(v); err != nil {
+		return "", errors.New("invalid " + field + " in path")
+	}
+	return v, nil
+}
@@ -0,0 +1,64 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type putSeasonTemplateBody struct {
+	Metric       string `json:"metric,omitempty"`
+	Timezone     string `json:"timezone"`
+	Period       string `json:"period"`
+	PeriodDays   int    `json:"period_days,omitempty"`
+	AutoActivate bool   `json:"auto_activate"`
+	// mesmas regras opcionais do POST de season; valem pra cada season gerada
+	EditWindowMinutes *int   `json:"edit_window_minutes,omitempty"`
+	MinPages          int    `json:"min_pages,omitempty"`
+	MinMinutes        int    `json:"min_minutes,omitempty"`
+	RequireGoal       bool   `json:"require_goal,omitempty"`
+	LateJoinPolicy    string `json:"late_join_policy,omitempty"`
+}
+
+func BuildPutSeasonTemplateInput(event events.APIGatewayV2HTTPRequest) (appSeason.PutSeasonTemplateInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appSeason.PutSeasonTemplateInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appSeason.PutSeasonTemplateInput{}, err
+	}
+
+	var body putSeasonTemplateBody
+	if err := json.Unmarshal([]byte(event.Body), &body); err != nil {
+		return appSeason.PutSeasonTemplateInput{}, errors.New("invalid request body")
+	}
+
+	if body.Timezone == "" {
+		return appSeason.PutSeasonTemplateInput{}, errors.New("timezone is required")
+	}
+	if body.Period == "" {
+		return appSeason.PutSeasonTemplateInput{}, errors.New("period is required")
+	}
+
+	return appSeason.PutSeasonTemplateInput{
+		Claims:            claims,
+		GroupID:           groupID,
+		Metric:            body.Metric,
+		Timezone:          body.Timezone,
+		Period:            body.Period,
+		PeriodDays:        body.PeriodDays,
+		AutoActivate:      body.AutoActivate,
+		EditWindowMinutes: body.EditWindowMinutes,
+		MinPages:          body.MinPages,
+		MinMinutes:        body.MinMinutes,
+		RequireGoal:       body.RequireGoal,
+		LateJoinPolicy:    body.LateJoinPolicy,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package httpapi
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	app "reading-cats-api/internal/application/reading"
+	readingDomain "reading-cats-api/internal/domain/reading"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// readingErrorResponse traduz os erros de correção/exclusão de leitura.
+func readingErrorResponse(event events.APIGatewayV2HTTPRequest, op string, err error) events.APIGatewayV2HTTPResponse {
+	switch {
+	case errors.Is(err, app.ErrUserNotFound):
+		return Error(event, http.StatusNotFound, "user not found")
+	case errors.Is(err, app.ErrReadingDayNotFound):
+		return Error(event, http.StatusNotFound, err.Error())
+	case errors.Is(err, readingDomain.ErrCheckinLocked):
+		return Error(event, http.StatusConflict, err.Error())
+	case errors.Is(err, readingDomain.ErrEmptyReading),
+		errors.Is(err, readingDomain.ErrInvalidDate),
+		errors.Is(err, readingDomain.ErrDayTotalOutOfRange):
+		return Error(event, http.StatusBadRequest, err.Error())
+	}
+
+	log.Printf("[httpapi] %s error: %v", op, err)
+	return Error(event, http.StatusInternalServerError, "internal error")
+}
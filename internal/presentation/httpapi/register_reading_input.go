@@ -10,8 +10,12 @@ import (
 	"github.com/aws/aws-lambda-go/events"
 )
 
+// pages e minutes são opcionais, mas pelo menos um tem que vir;
+// source identifica o cliente (ex: "ios", "widget")
 type registerReadingBody struct {
-	Pages int `json:"pages"`
+	Pages   int    `json:"pages"`
+	Minutes int    `json:"minutes"`
+	Source  string `json:"source"`
 }
 
 func BuildRegisterReadingInput(event events.APIGatewayV2HTTPRequest) (app.RegisterReadingInput, error) {
@@ -27,13 +31,47 @@ func BuildRegisterReadingInput(event events.APIGatewayV2HTTPRequest) (app.Regist
 		return app.RegisterReadingInput{}, errors.New("invalid request body")
 	}
 
-	pagesVO, err := readingDomain.NewPages(body.Pages)
+	pagesVO, minutesVO, err := readingAmounts(body.Pages, body.Minutes)
+	if err != nil {
+		return app.RegisterReadingInput{}, err
+	}
+
+	source, err := readingDomain.NewSource(body.Source)
 	if err != nil {
 		return app.RegisterReadingInput{}, err
 	}
 
 	return app.RegisterReadingInput{
-		Claims: claims,
-		Pages:  pagesVO,
+		Claims:  claims,
+		Pages:   pagesVO,
+		Minutes: minutesVO,
+		Source:  source,
 	}, nil
 }
+
+// readingAmounts valida páginas/minutos de um corpo de leitura (0 = não informado).
+func readingAmounts(pages, minutes int) (readingDomain.Pages, readingDomain.Minutes, error) {
+	if pages == 0 && minutes == 0 {
+		return 0, 0, readingDomain.ErrEmptyReading
+	}
+
+	var pagesVO readingDomain.Pages
+	if pages != 0 {
+		p, err := readingDomain.NewPages(pages)
+		if err != nil {
+			return 0, 0, err
+		}
+		pagesVO = p
+	}
+
+	var minutesVO readingDomain.Minutes
+	if minutes != 0 {
+		m, err := readingDomain.NewMinutes(minutes)
+		if err != nil {
+			return 0, 0, err
+		}
+		minutesVO = m
+	}
+
+	return pagesVO, minutesVO, nil
+}
@@ -0,0 +1,34 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type RemoveMemberHandler struct {
+	uc *appGroup.RemoveMemberUseCase
+}
+
+func NewRemoveMemberHandler(uc *appGroup.RemoveMemberUseCase) *RemoveMemberHandler {
+	return &RemoveMemberHandler{uc: uc}
+}
+
+func (h *RemoveMemberHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildRemoveMemberInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	if err := h.uc.Execute(ctx, in); err != nil {
+		return groupErrorResponse(event, "RemoveMember", err), nil
+	}
+
+	return NoContent(), nil
+}
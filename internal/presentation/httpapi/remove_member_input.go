@@ -0,0 +1,31 @@
+package httpapi
+
+import (
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func BuildRemoveMemberInput(event events.APIGatewayV2HTTPRequest) (appGroup.RemoveMemberInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.RemoveMemberInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.RemoveMemberInput{}, err
+	}
+
+	userID, err := uuidPathParam(event, "userId", "user_id")
+	if err != nil {
+		return appGroup.RemoveMemberInput{}, err
+	}
+
+	return appGroup.RemoveMemberInput{
+		Claims:  claims,
+		GroupID: groupID,
+		UserID:  userID,
+	}, nil
+}
@@ -3,6 +3,7 @@ package httpapi
 import (
 	"encoding/json"
 	"log"
+	"net/http"
 
 	"github.com/aws/aws-lambda-go/events"
 )
@@ -24,6 +25,10 @@ func JSON(status int, body any) events.APIGatewayV2HTTPResponse {
 	}
 }
 
+func NoContent() events.APIGatewayV2HTTPResponse {
+	return events.APIGatewayV2HTTPResponse{StatusCode: http.StatusNoContent}
+}
+
 func Error(event events.APIGatewayV2HTTPRequest, status int, msg string) events.APIGatewayV2HTTPResponse {
 	reqID := event.RequestContext.RequestID
 	method := event.RequestContext.HTTP.Method
@@ -3,28 +3,118 @@ package httpapi
 import (
 	"context"
 	"net/http"
-	"strings"
 
 	"github.com/aws/aws-lambda-go/events"
 )
 
 type Router struct {
-	me                 *MeHandler
-	registerReading    *RegisterReadingHandler
-	getReadingProgress *GetReadingProgressHandler
-	changeGoal         *ChangeGoalHandler
-	createGroup        *CreateGroupHandler
-	createSeason       *CreateSeasonHandler
+	me                   *MeHandler
+	registerReading      *RegisterReadingHandler
+	getReadingProgress   *GetReadingProgressHandler
+	listReadingLogs      *ListReadingLogsHandler
+	editReading          *EditReadingHandler
+	deleteReading        *DeleteReadingHandler
+	changeGoal           *ChangeGoalHandler
+	createGroup          *CreateGroupHandler
+	listMyGroups         *ListMyGroupsHandler
+	discoverGroups       *DiscoverGroupsHandler
+	getGroup             *GetGroupHandler
+	getFeed              *GetFeedHandler
+	updateGroup          *UpdateGroupHandler
+	archiveGroup         *ArchiveGroupHandler
+	deleteGroup          *DeleteGroupHandler
+	createInvite         *CreateInviteHandler
+	acceptInvite         *AcceptInviteHandler
+	leaveGroup           *LeaveGroupHandler
+	removeMember         *RemoveMemberHandler
+	changeMemberRole     *ChangeMemberRoleHandler
+	transferOwnership    *TransferOwnershipHandler
+	createJoinRequest    *CreateJoinRequestHandler
+	listJoinRequests     *ListJoinRequestsHandler
+	decideJoinRequest    *DecideJoinRequestHandler
+	createSeason         *CreateSeasonHandler
+	listSeasons          *ListSeasonsHandler
+	activateSeason       *ActivateSeasonHandler
+	getLeaderboard       *GetLeaderboardHandler
+	assignTeams          *AssignTeamsHandler
+	getTeamLeaderboard   *GetTeamLeaderboardHandler
+	getSeasonResults     *GetSeasonResultsHandler
+	putSeasonTemplate    *PutSeasonTemplateHandler
+	getSeasonTemplate    *GetSeasonTemplateHandler
+	deleteSeasonTemplate *DeleteSeasonTemplateHandler
 }
 
-func NewRouter(me *MeHandler, readingHandler *RegisterReadingHandler, getReadingProgress *GetReadingProgressHandler, changeGoal *ChangeGoalHandler, createGroup *CreateGroupHandler, createSeason *CreateSeasonHandler) *Router {
+func NewRouter(
+	me *MeHandler,
+	readingHandler *RegisterReadingHandler,
+	getReadingProgress *GetReadingProgressHandler,
+	listReadingLogs *ListReadingLogsHandler,
+	editReading *EditReadingHandler,
+	deleteReading *DeleteReadingHandler,
+	changeGoal *ChangeGoalHandler,
+	createGroup *CreateGroupHandler,
+	listMyGroups *ListMyGroupsHandler,
+	discoverGroups *DiscoverGroupsHandler,
+	getGroup *GetGroupHandler,
+	getFeed *GetFeedHandler,
+	updateGroup *UpdateGroupHandler,
+	archiveGroup *ArchiveGroupHandler,
+	deleteGroup *DeleteGroupHandler,
+	createInvite *CreateInviteHandler,
+	acceptInvite *AcceptInviteHandler,
+	leaveGroup *LeaveGroupHandler,
+	removeMember *RemoveMemberHandler,
+	changeMemberRole *ChangeMemberRoleHandler,
+	transferOwnership *TransferOwnershipHandler,
+	createJoinRequest *CreateJoinRequestHandler,
+	listJoinRequests *ListJoinRequestsHandler,
+	decideJoinRequest *DecideJoinRequestHandler,
+	createSeason *CreateSeasonHandler,
+	listSeasons *ListSeasonsHandler,
+	activateSeason *ActivateSeasonHandler,
+	getLeaderboard *GetLeaderboardHandler,
+	assignTeams *AssignTeamsHandler,
+	getTeamLeaderboard *GetTeamLeaderboardHandler,
+	getSeasonResults *GetSeasonResultsHandler,
+	putSeasonTemplate *PutSeasonTemplateHandler,
+	getSeasonTemplate *GetSeasonTemplateHandler,
+	deleteSeasonTemplate *DeleteSeasonTemplateHandler,
+) *Router {
 	return &Router{
-		me:                 me,
-		registerReading:    readingHandler,
-		getReadingProgress: getReadingProgress,
-		changeGoal:         changeGoal,
-		createGroup:        createGroup,
-		createSeason:       createSeason,
+		me:                   me,
+		registerReading:      readingHandler,
+		getReadingProgress:   getReadingProgress,
+		listReadingLogs:      listReadingLogs,
+		editReading:          editReading,
+		deleteReading:        deleteReading,
+		changeGoal:           changeGoal,
+		createGroup:          createGroup,
+		listMyGroups:         listMyGroups,
+		discoverGroups:       discoverGroups,
+		getGroup:             getGroup,
+		getFeed:              getFeed,
+		updateGroup:          updateGroup,
+		archiveGroup:         archiveGroup,
+		deleteGroup:          deleteGroup,
+		createInvite:         createInvite,
+		acceptInvite:         acceptInvite,
+		leaveGroup:           leaveGroup,
+		removeMember:         removeMember,
+		changeMemberRole:     changeMemberRole,
+		transferOwnership:    transferOwnership,
+		createJoinRequest:    createJoinRequest,
+		listJoinRequests:     listJoinRequests,
+		decideJoinRequest:    decideJoinRequest,
+		createSeason:         createSeason,
+		listSeasons:          listSeasons,
+		activateSeason:       activateSeason,
+		getLeaderboard:       getLeaderboard,
+		assignTeams:          assignTeams,
+		getTeamLeaderboard:   getTeamLeaderboard,
+		getSeasonResults:     getSeasonResults,
+		putSeasonTemplate:    putSeasonTemplate,
+		getSeasonTemplate:    getSeasonTemplate,
+		deleteSeasonTemplate: deleteSeasonTemplate,
 	}
 }
 
@@ -37,6 +127,18 @@ func (r *Router) Route(ctx context.Context, event events.APIGatewayV2HTTPRequest
 		return r.registerReading.Handle(ctx, event)
 	}
 
+	if event.RequestContext.HTTP.Method == http.MethodGet && event.RawPath == "/v1/reading/logs" {
+		return r.listReadingLogs.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPut && r.match(&event, "/v1/reading/logs/{date}") {
+		return r.editReading.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodDelete && r.match(&event, "/v1/reading/logs/{date}") {
+		return r.deleteReading.Handle(ctx, event)
+	}
+
 	if event.RequestContext.HTTP.Method == http.MethodGet && event.RawPath == "/v1/reading/progress" {
 		return r.getReadingProgress.Handle(ctx, event)
 	}
@@ -49,10 +151,125 @@ func (r *Router) Route(ctx context.Context, event events.APIGatewayV2HTTPRequest
 		return r.createGroup.Handle(ctx, event)
 	}
 
-	// POST /v1/groups/{groupId}/seasons
-	if event.RequestContext.HTTP.Method == http.MethodPost && strings.HasPrefix(event.RawPath, "/v1/groups/") && strings.HasSuffix(event.RawPath, "/seasons") {
+	if event.RequestContext.HTTP.Method == http.MethodGet && event.RawPath == "/v1/groups" {
+		return r.listMyGroups.Handle(ctx, event)
+	}
+
+	// antes de /v1/groups/{groupId}, senão "discover" seria lido como groupId
+	if event.RequestContext.HTTP.Method == http.MethodGet && event.RawPath == "/v1/groups/discover" {
+		return r.discoverGroups.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodGet && r.match(&event, "/v1/groups/{groupId}") {
+		return r.getGroup.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodGet && r.match(&event, "/v1/groups/{groupId}/feed") {
+		return r.getFeed.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPatch && r.match(&event, "/v1/groups/{groupId}") {
+		return r.updateGroup.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodDelete && r.match(&event, "/v1/groups/{groupId}") {
+		return r.deleteGroup.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPost && r.match(&event, "/v1/groups/{groupId}/archive") {
+		return r.archiveGroup.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPost && r.match(&event, "/v1/groups/{groupId}/invites") {
+		return r.createInvite.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPost && r.match(&event, "/v1/invites/{code}/accept") {
+		return r.acceptInvite.Handle(ctx, event)
+	}
+
+	// "me" precisa vir antes de {userId}
+	if event.RequestContext.HTTP.Method == http.MethodDelete && r.match(&event, "/v1/groups/{groupId}/members/me") {
+		return r.leaveGroup.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodDelete && r.match(&event, "/v1/groups/{groupId}/members/{userId}") {
+		return r.removeMember.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPut && r.match(&event, "/v1/groups/{groupId}/members/{userId}/role") {
+		return r.changeMemberRole.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPut && r.match(&event, "/v1/groups/{groupId}/owner") {
+		return r.transferOwnership.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPost && r.match(&event, "/v1/groups/{groupId}/join-requests") {
+		return r.createJoinRequest.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodGet && r.match(&event, "/v1/groups/{groupId}/join-requests") {
+		return r.listJoinRequests.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPost && r.match(&event, "/v1/groups/{groupId}/join-requests/{requestId}/approve") {
+		return r.decideJoinRequest.Approve(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPost && r.match(&event, "/v1/groups/{groupId}/join-requests/{requestId}/reject") {
+		return r.decideJoinRequest.Reject(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPost && r.match(&event, "/v1/groups/{groupId}/seasons") {
 		return r.createSeason.Handle(ctx, event)
 	}
 
+	if event.RequestContext.HTTP.Method == http.MethodGet && r.match(&event, "/v1/groups/{groupId}/seasons") {
+		return r.listSeasons.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPost && r.match(&event, "/v1/groups/{groupId}/seasons/{seasonId}/activate") {
+		return r.activateSeason.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodGet && r.match(&event, "/v1/groups/{groupId}/seasons/{seasonId}/leaderboard") {
+		return r.getLeaderboard.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodPut && r.match(&event, "/v1/groups/{groupId}/seasons/{seasonId}/teams") {
+		return r.assignTeams.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodGet && r.match(&event, "/v1/groups/{groupId}/seasons/{seasonId}/teams/leaderboard") {
+		return r.getTeamLeaderboard.Handle(ctx, event)
+	}
+
+	if event.RequestContext.HTTP.Method == http.MethodGet && r.match(&event, "/v1/groups/{groupId}/seasons/{seasonId}/results") {
+		return r.getSeasonResults.Handle(ctx, event)
+	}
+
+	if r.match(&event, "/v1/groups/{groupId}/season-template") {
+		switch event.RequestContext.HTTP.Method {
+		case http.MethodPut:
+			return r.putSeasonTemplate.Handle(ctx, event)
+		case http.MethodGet:
+			return r.getSeasonTemplate.Handle(ctx, event)
+		case http.MethodDelete:
+			return r.deleteSeasonTemplate.Handle(ctx, event)
+		}
+	}
+
 	return events.APIGatewayV2HTTPResponse{StatusCode: http.StatusNotFound}, nil
 }
+
+// match testa o RawPath contra o padrão e, se casar, expõe os parâmetros em event.PathParameters
+// (com a rota {proxy+} do API Gateway esse campo só traz "proxy").
+func (r *Router) match(event *events.APIGatewayV2HTTPRequest, pattern string) bool {
+	params, ok := matchPath(pattern, event.RawPath)
+	if !ok {
+		return false
+	}
+	event.PathParameters = params
+	return true
+}
@@ -0,0 +1,47 @@
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+
+	appSeason "reading-cats-api/internal/application/season"
+	domainSeason "reading-cats-api/internal/domain/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// seasonErrorResponse traduz os erros dos use cases de season; o que não for
+// específico de season (autorização, grupo inexistente...) cai no mapeamento de grupo.
+func seasonErrorResponse(event events.APIGatewayV2HTTPRequest, op string, err error) events.APIGatewayV2HTTPResponse {
+	switch {
+	case errors.Is(err, appSeason.ErrUserNotFound):
+		return Error(event, http.StatusNotFound, "user not found")
+	case errors.Is(err, appSeason.ErrSeasonNotFound),
+		errors.Is(err, appSeason.ErrSeasonTemplateNotFound),
+		errors.Is(err, appSeason.ErrSeasonTeamsNotFound):
+		return Error(event, http.StatusNotFound, err.Error())
+	case errors.Is(err, domainSeason.ErrActiveSeasonExists),
+		errors.Is(err, domainSeason.ErrSeasonNotEnded),
+		errors.Is(err, domainSeason.ErrSeasonNotDraft),
+		errors.Is(err, domainSeason.ErrSeasonNotActive),
+		errors.Is(err, domainSeason.ErrTeamsOutOfDate):
+		return Error(event, http.StatusConflict, err.Error())
+	case errors.Is(err, domainSeason.ErrEndsAtNotInFuture),
+		errors.Is(err, domainSeason.ErrInvalidTimezone),
+		errors.Is(err, domainSeason.ErrInvalidMetric),
+		errors.Is(err, domainSeason.ErrInvalidPeriod),
+		errors.Is(err, domainSeason.ErrInvalidEditWindow),
+		errors.Is(err, domainSeason.ErrInvalidQualification),
+		errors.Is(err, domainSeason.ErrInvalidLateJoinPolicy),
+		errors.Is(err, domainSeason.ErrInvalidTeamCount),
+		errors.Is(err, domainSeason.ErrInvalidTeamName),
+		errors.Is(err, domainSeason.ErrDuplicateTeamName),
+		errors.Is(err, domainSeason.ErrEmptyTeam),
+		errors.Is(err, domainSeason.ErrTeamMemberNotInGroup),
+		errors.Is(err, domainSeason.ErrTeamMemberAssignedTwice),
+		errors.Is(err, domainSeason.ErrTeamMemberUnassigned):
+		return Error(event, http.StatusBadRequest, err.Error())
+	}
+
+	return groupErrorResponse(event, op, err)
+}
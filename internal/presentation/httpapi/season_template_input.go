@@ -0,0 +1,26 @@
+package httpapi
+
+import (
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// BuildSeasonTemplateInput atende GET e DELETE .../season-template.
+func BuildSeasonTemplateInput(event events.APIGatewayV2HTTPRequest) (appSeason.SeasonTemplateInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appSeason.SeasonTemplateInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appSeason.SeasonTemplateInput{}, err
+	}
+
+	return appSeason.SeasonTemplateInput{
+		Claims:  claims,
+		GroupID: groupID,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/google/uuid"
+)
+
+type transferOwnershipBody struct {
+	UserID string `json:"user_id"`
+}
+
+func BuildTransferOwnershipInput(event events.APIGatewayV2HTTPRequest) (appGroup.TransferOwnershipInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.TransferOwnershipInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.TransferOwnershipInput{}, err
+	}
+
+	// Parse body
+	var body transferOwnershipBody
+	if err := json.Unmarshal([]byte(event.Body), &body); err != nil {
+		return appGroup.TransferOwnershipInput{}, errors.New("invalid request body")
+	}
+
+	if err := uuid.Validate(body.UserID); err != nil {
+		return appGroup.TransferOwnershipInput{}, errors.New("user_id is required")
+	}
+
+	return appGroup.TransferOwnershipInput{
+		Claims:  claims,
+		GroupID: groupID,
+		UserID:  body.UserID,
+	}, nil
+}
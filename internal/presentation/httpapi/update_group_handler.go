@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type UpdateGroupHandler struct {
+	uc *appGroup.UpdateGroupUseCase
+}
+
+func NewUpdateGroupHandler(uc *appGroup.UpdateGroupUseCase) *UpdateGroupHandler {
+	return &UpdateGroupHandler{uc: uc}
+}
+
+func (h *UpdateGroupHandler) Handle(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	in, err := BuildUpdateGroupInput(event)
+	if err != nil {
+		if err == ErrUnauthorized {
+			return Error(event, http.StatusUnauthorized, err.Error()), nil
+		}
+		return Error(event, http.StatusBadRequest, err.Error()), nil
+	}
+
+	out, err := h.uc.Execute(ctx, in)
+	if err != nil {
+		return groupErrorResponse(event, "UpdateGroup", err), nil
+	}
+
+	return JSON(http.StatusOK, out), nil
+}
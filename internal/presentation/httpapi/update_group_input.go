@@ -0,0 +1,47 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+
+	appGroup "reading-cats-api/internal/application/group"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type updateGroupBody struct {
+	Name       *string `json:"name,omitempty"`
+	IconID     *string `json:"icon_id,omitempty"`
+	MaxMembers *int    `json:"max_members,omitempty"`
+}
+
+func BuildUpdateGroupInput(event events.APIGatewayV2HTTPRequest) (appGroup.UpdateGroupInput, error) {
+	// Extract claims
+	claims, err := ExtractClaims(event)
+	if err != nil {
+		return appGroup.UpdateGroupInput{}, err
+	}
+
+	groupID, err := uuidPathParam(event, "groupId", "group_id")
+	if err != nil {
+		return appGroup.UpdateGroupInput{}, err
+	}
+
+	// Parse body
+	var body updateGroupBody
+	if err := json.Unmarshal([]byte(event.Body), &body); err != nil {
+		return appGroup.UpdateGroupInput{}, errors.New("invalid request body")
+	}
+
+	if body.Name == nil && body.IconID == nil && body.MaxMembers == nil {
+		return appGroup.UpdateGroupInput{}, errors.New("at least one of name, icon_id or max_members is required")
+	}
+
+	return appGroup.UpdateGroupInput{
+		Claims:     claims,
+		GroupID:    groupID,
+		Name:       body.Name,
+		IconID:     body.IconID,
+		MaxMembers: body.MaxMembers,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package scheduled
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	appSeason "reading-cats-api/internal/application/season"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// Handler atende os eventos agendados do EventBridge (a mesma binária do HTTP, outra função no template).
+type Handler struct {
+	endSeasons  *appSeason.EndExpiredSeasonsUseCase
+	nextSeasons *appSeason.CreateNextSeasonsUseCase
+}
+
+func NewHandler(endSeasons *appSeason.EndExpiredSeasonsUseCase, nextSeasons *appSeason.CreateNextSeasonsUseCase) *Handler {
+	return &Handler{endSeasons: endSeasons, nextSeasons: nextSeasons}
+}
+
+func (h *Handler) Handle(ctx context.Context, event events.EventBridgeEvent) error {
+	log.Printf("[scheduled] %s event %s", event.DetailType, event.ID)
+	return h.RunOnce(ctx)
+}
+
+// RunOnce executa o job uma vez; usado pelo Lambda e pelo modo linha de comando local.
+// As próximas seasons são criadas mesmo se algum encerramento falhou: cada etapa é idempotente.
+func (h *Handler) RunOnce(ctx context.Context) error {
+	ended, endErr := h.endSeasons.Execute(ctx)
+	log.Printf("[scheduled] ended %d expired season(s): %v", len(ended.Ended), ended.Ended)
+
+	created, nextErr := h.nextSeasons.Execute(ctx)
+	log.Printf("[scheduled] created %d season(s) from templates: %v", len(created.Created), created.Created)
+
+	return errors.Join(endErr, nextErr)
+}
@@ -10,6 +10,7 @@ import (
 	appSeason "reading-cats-api/internal/application/season"
 	appUser "reading-cats-api/internal/application/user"
 	"reading-cats-api/internal/config"
+	readingDomain "reading-cats-api/internal/domain/reading"
 	"reading-cats-api/internal/infra/db"
 	infraGroup "reading-cats-api/internal/infra/group"
 	infraReading "reading-cats-api/internal/infra/reading"
@@ -17,49 +18,190 @@ import (
 	infraUser "reading-cats-api/internal/infra/user"
 	"reading-cats-api/internal/presentation/httpapi"
 	httpReading "reading-cats-api/internal/presentation/httpapi"
+	"reading-cats-api/internal/presentation/scheduled"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 )
 
-var router *httpapi.Router
+var (
+	router    *httpapi.Router
+	scheduler *scheduled.Handler
+	cfg       config.Config
+)
 
 func init() {
 	log.SetOutput(os.Stdout)
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 	ctx := context.Background()
-	cfg := config.Load()
+	cfg = config.Load()
 
 	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
 	if err != nil {
 		panic(err)
 	}
 
+	// fuso inválido derruba o cold start em vez de estourar no primeiro registro
+	days, err := readingDomain.NewDayBoundary(cfg.Timezone)
+	if err != nil {
+		panic("invalid APP_TIMEZONE: " + cfg.Timezone)
+	}
+
 	// user/me
 	userRepo := infraUser.NewPostgresRepository(pool)
 	userUC := appUser.NewEnsureMeUseCase(userRepo)
 	meHandler := httpapi.NewMeHandler(userUC)
 
+	// group: repo e policy compartilhados; o hook leva os check-ins pro feed dos grupos
+	groupRepo := infraGroup.NewPostgresRepository(pool)
+	groupPolicy := appGroup.NewPolicy(groupRepo)
+	groupCheckinHook := appGroup.NewCheckinHook(groupRepo)
+	// season: repo criado cedo porque o arquivamento do grupo congela os resultados da season
+	seasonRepo := infraSeason.NewPostgresRepository(pool)
+	seasonResults := appSeason.NewResultsSnapshotter(seasonRepo)
+
 	// reading/logs
 	readingRepo := infraReading.NewPostgresRepository(pool)
-	readingUC := appReading.NewRegisterReadingUseCase(readingRepo, userRepo, "America/Sao_Paulo")
-	getReadingProgressUC := appReading.NewGetReadingProgressUseCase(readingRepo, userRepo, "America/Sao_Paulo")
-	changeGoalUC := appReading.NewChangeGoalUseCase(readingRepo, userRepo, "America/Sao_Paulo")
+	readingUC := appReading.NewRegisterReadingUseCase(readingRepo, userRepo, groupCheckinHook, days)
+	getReadingProgressUC := appReading.NewGetReadingProgressUseCase(readingRepo, userRepo, days)
+	changeGoalUC := appReading.NewChangeGoalUseCase(readingRepo, userRepo, days)
 	registerReadingHandler := httpReading.NewRegisterReadingHandler(readingUC)
 	getReadingProgressHandler := httpReading.NewGetReadingProgressHandler(getReadingProgressUC)
 	changeGoalHandler := httpReading.NewChangeGoalHandler(changeGoalUC)
+	listReadingLogsHandler := httpReading.NewListReadingLogsHandler(appReading.NewListReadingLogsUseCase(readingRepo, userRepo, days))
+	editReadingHandler := httpReading.NewEditReadingHandler(appReading.NewEditReadingUseCase(readingRepo, userRepo, groupCheckinHook))
+	deleteReadingHandler := httpReading.NewDeleteReadingHandler(appReading.NewDeleteReadingUseCase(readingRepo, userRepo, groupCheckinHook))
 
 	// group/create
-	groupRepo := infraGroup.NewPostgresRepository(pool)
 	createGroupUC := appGroup.NewCreateGroupUseCase(groupRepo, userRepo)
 	createGroupHandler := httpapi.NewCreateGroupHandler(createGroupUC)
 
+	// group/list
+	listMyGroupsUC := appGroup.NewListMyGroupsUseCase(groupRepo, userRepo)
+	listMyGroupsHandler := httpapi.NewListMyGroupsHandler(listMyGroupsUC)
+
+	discoverGroupsUC := appGroup.NewDiscoverGroupsUseCase(groupRepo, userRepo)
+	discoverGroupsHandler := httpapi.NewDiscoverGroupsHandler(discoverGroupsUC)
+
+	// group/detail
+	getGroupUC := appGroup.NewGetGroupUseCase(groupRepo, userRepo, groupPolicy, days)
+	getGroupHandler := httpapi.NewGetGroupHandler(getGroupUC)
+
+	// group/feed
+	getFeedUC := appGroup.NewGetFeedUseCase(groupRepo, userRepo, groupPolicy)
+	getFeedHandler := httpapi.NewGetFeedHandler(getFeedUC)
+
+	updateGroupUC := appGroup.NewUpdateGroupUseCase(groupRepo, userRepo, groupPolicy)
+	updateGroupHandler := httpapi.NewUpdateGroupHandler(updateGroupUC)
+
+	archiveGroupUC := appGroup.NewArchiveGroupUseCase(groupRepo, userRepo, groupPolicy, seasonResults)
+	archiveGroupHandler := httpapi.NewArchiveGroupHandler(archiveGroupUC)
+
+	deleteGroupUC := appGroup.NewDeleteGroupUseCase(groupRepo, userRepo, groupPolicy)
+	deleteGroupHandler := httpapi.NewDeleteGroupHandler(deleteGroupUC)
+
+	// group/invites
+	createInviteUC := appGroup.NewCreateInviteUseCase(groupRepo, userRepo, groupPolicy)
+	acceptInviteUC := appGroup.NewAcceptInviteUseCase(groupRepo, userRepo)
+	createInviteHandler := httpapi.NewCreateInviteHandler(createInviteUC)
+	acceptInviteHandler := httpapi.NewAcceptInviteHandler(acceptInviteUC)
+
+	// group/members
+	leaveGroupUC := appGroup.NewLeaveGroupUseCase(groupRepo, userRepo)
+	removeMemberUC := appGroup.NewRemoveMemberUseCase(groupRepo, userRepo, groupPolicy)
+	leaveGroupHandler := httpapi.NewLeaveGroupHandler(leaveGroupUC)
+	removeMemberHandler := httpapi.NewRemoveMemberHandler(removeMemberUC)
+
+	// group/roles
+	changeMemberRoleUC := appGroup.NewChangeMemberRoleUseCase(groupRepo, userRepo, groupPolicy)
+	transferOwnershipUC := appGroup.NewTransferOwnershipUseCase(groupRepo, userRepo, groupPolicy)
+	changeMemberRoleHandler := httpapi.NewChangeMemberRoleHandler(changeMemberRoleUC)
+	transferOwnershipHandler := httpapi.NewTransferOwnershipHandler(transferOwnershipUC)
+
+	createJoinRequestUC := appGroup.NewCreateJoinRequestUseCase(groupRepo, userRepo)
+	createJoinRequestHandler := httpapi.NewCreateJoinRequestHandler(createJoinRequestUC)
+
+	listJoinRequestsUC := appGroup.NewListJoinRequestsUseCase(groupRepo, userRepo, groupPolicy)
+	listJoinRequestsHandler := httpapi.NewListJoinRequestsHandler(listJoinRequestsUC)
+
+	decideJoinRequestUC := appGroup.NewDecideJoinRequestUseCase(groupRepo, userRepo, groupPolicy)
+	decideJoinRequestHandler := httpapi.NewDecideJoinRequestHandler(decideJoinRequestUC)
+
 	// season/create
-	seasonRepo := infraSeason.NewPostgresRepository(pool)
-	createSeasonUC := appSeason.NewCreateSeasonUseCase(seasonRepo, userRepo)
+	createSeasonUC := appSeason.NewCreateSeasonUseCase(seasonRepo, userRepo, groupPolicy, groupRepo)
 	createSeasonHandler := httpapi.NewCreateSeasonHandler(createSeasonUC)
 
-	router = httpapi.NewRouter(meHandler, registerReadingHandler, getReadingProgressHandler, changeGoalHandler, createGroupHandler, createSeasonHandler)
+	// season/list
+	listSeasonsUC := appSeason.NewListSeasonsUseCase(seasonRepo, userRepo, groupPolicy)
+	listSeasonsHandler := httpapi.NewListSeasonsHandler(listSeasonsUC)
+
+	// season/activate
+	activateSeasonUC := appSeason.NewActivateSeasonUseCase(seasonRepo, userRepo, groupPolicy, groupRepo, groupRepo)
+	activateSeasonHandler := httpapi.NewActivateSeasonHandler(activateSeasonUC)
+
+	// season/leaderboard
+	getLeaderboardUC := appSeason.NewGetLeaderboardUseCase(seasonRepo, userRepo, groupPolicy)
+	getLeaderboardHandler := httpapi.NewGetLeaderboardHandler(getLeaderboardUC)
+
+	// season/teams
+	assignTeamsUC := appSeason.NewAssignTeamsUseCase(seasonRepo, userRepo, groupPolicy)
+	assignTeamsHandler := httpapi.NewAssignTeamsHandler(assignTeamsUC)
+	getTeamLeaderboardUC := appSeason.NewGetTeamLeaderboardUseCase(seasonRepo, userRepo, groupPolicy)
+	getTeamLeaderboardHandler := httpapi.NewGetTeamLeaderboardHandler(getTeamLeaderboardUC)
+
+	// season/results
+	getSeasonResultsUC := appSeason.NewGetSeasonResultsUseCase(seasonRepo, userRepo, groupPolicy)
+	getSeasonResultsHandler := httpapi.NewGetSeasonResultsHandler(getSeasonResultsUC)
+
+	// season/template
+	putSeasonTemplateUC := appSeason.NewPutSeasonTemplateUseCase(seasonRepo, userRepo, groupPolicy)
+	getSeasonTemplateUC := appSeason.NewGetSeasonTemplateUseCase(seasonRepo, userRepo, groupPolicy)
+	deleteSeasonTemplateUC := appSeason.NewDeleteSeasonTemplateUseCase(seasonRepo, userRepo, groupPolicy)
+	putSeasonTemplateHandler := httpapi.NewPutSeasonTemplateHandler(putSeasonTemplateUC)
+	getSeasonTemplateHandler := httpapi.NewGetSeasonTemplateHandler(getSeasonTemplateUC)
+	deleteSeasonTemplateHandler := httpapi.NewDeleteSeasonTemplateHandler(deleteSeasonTemplateUC)
+
+	// scheduled: encerra seasons vencidas e cria as próximas dos templates
+	endExpiredSeasonsUC := appSeason.NewEndExpiredSeasonsUseCase(seasonRepo, groupRepo)
+	createNextSeasonsUC := appSeason.NewCreateNextSeasonsUseCase(seasonRepo, groupRepo)
+	scheduler = scheduled.NewHandler(endExpiredSeasonsUC, createNextSeasonsUC)
+
+	router = httpapi.NewRouter(
+		meHandler,
+		registerReadingHandler,
+		getReadingProgressHandler,
+		listReadingLogsHandler,
+		editReadingHandler,
+		deleteReadingHandler,
+		changeGoalHandler,
+		createGroupHandler,
+		listMyGroupsHandler,
+		discoverGroupsHandler,
+		getGroupHandler,
+		getFeedHandler,
+		updateGroupHandler,
+		archiveGroupHandler,
+		deleteGroupHandler,
+		createInviteHandler,
+		acceptInviteHandler,
+		leaveGroupHandler,
+		removeMemberHandler,
+		changeMemberRoleHandler,
+		transferOwnershipHandler,
+		createJoinRequestHandler,
+		listJoinRequestsHandler,
+		decideJoinRequestHandler,
+		createSeasonHandler,
+		listSeasonsHandler,
+		activateSeasonHandler,
+		getLeaderboardHandler,
+		assignTeamsHandler,
+		getTeamLeaderboardHandler,
+		getSeasonResultsHandler,
+		putSeasonTemplateHandler,
+		getSeasonTemplateHandler,
+		deleteSeasonTemplateHandler,
+	)
 }
 
 func handler(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
@@ -67,5 +209,18 @@ func handler(ctx context.Context, event events.APIGatewayV2HTTPRequest) (events.
 }
 
 func main() {
+	// modo one-shot local do job agendado (encerra seasons vencidas e cria as próximas
+	// pelos templates): go run . run-season-scheduler
+	if len(os.Args) > 1 && os.Args[1] == "run-season-scheduler" {
+		if err := scheduler.RunOnce(context.Background()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if cfg.Handler == "scheduled" {
+		lambda.Start(scheduler.Handle)
+		return
+	}
 	lambda.Start(handler)
 }
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_group_invites_group_id;
+DROP TABLE IF EXISTS group_invites;
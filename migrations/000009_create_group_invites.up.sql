@@ -0,0 +1,14 @@
+-- Convites para grupos (INVITE_ONLY)
+CREATE TABLE group_invites (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  group_id uuid NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+  code varchar(16) NOT NULL UNIQUE,
+  created_by_user_id uuid NOT NULL REFERENCES users(id),
+  expires_at timestamptz NOT NULL,
+  single_use boolean NOT NULL DEFAULT false,
+  uses int NOT NULL DEFAULT 0,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT group_invites_uses_chk CHECK (uses >= 0)
+);
+
+CREATE INDEX idx_group_invites_group_id ON group_invites(group_id);
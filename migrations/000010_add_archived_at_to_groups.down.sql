@@ -0,0 +1 @@
+ALTER TABLE groups DROP COLUMN archived_at;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS group_join_requests;
+DROP TYPE IF EXISTS group_join_request_status;
+DROP INDEX IF EXISTS idx_group_checkins_group_created;
+DROP INDEX IF EXISTS idx_groups_public_name_trgm;
@@ -0,0 +1,26 @@
+-- Busca de grupos públicos por nome (prefixo/trigram)
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_groups_public_name_trgm ON groups USING gin (name gin_trgm_ops)
+  WHERE visibility = 'PUBLIC_SOON' AND archived_at IS NULL;
+
+-- "Atividade" do grupo = último check-in replicado pra ele
+CREATE INDEX idx_group_checkins_group_created ON group_checkins(group_id, created_at DESC);
+
+-- Pedidos de entrada em grupos públicos, aprovados/recusados por admins
+CREATE TYPE group_join_request_status AS ENUM ('PENDING', 'APPROVED', 'REJECTED');
+
+CREATE TABLE group_join_requests (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  group_id uuid NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  status group_join_request_status NOT NULL DEFAULT 'PENDING',
+  created_at timestamptz NOT NULL DEFAULT now(),
+  decided_at timestamptz NULL,
+  decided_by_user_id uuid NULL REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- no máximo um pedido pendente por usuário/grupo
+CREATE UNIQUE INDEX group_join_requests_one_pending_key ON group_join_requests(group_id, user_id)
+  WHERE status = 'PENDING';
+CREATE INDEX idx_group_join_requests_group_status ON group_join_requests(group_id, status, created_at);
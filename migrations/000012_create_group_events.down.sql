@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS group_events;
+DROP TYPE IF EXISTS group_event_type;
@@ -0,0 +1,22 @@
+-- Feed de atividade do grupo: eventos gravados na mesma transação da mudança que os origina
+CREATE TYPE group_event_type AS ENUM (
+  'GROUP_CREATED',
+  'MEMBER_JOINED',
+  'MEMBER_LEFT',
+  'SEASON_STARTED',
+  'SEASON_ENDED',
+  'MEMBER_CHECKED_IN',
+  'STREAK_MILESTONE'
+);
+
+CREATE TABLE group_events (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  group_id uuid NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+  type group_event_type NOT NULL,
+  actor_user_id uuid NULL REFERENCES users(id) ON DELETE SET NULL,
+  season_id uuid NULL REFERENCES group_seasons(id) ON DELETE SET NULL,
+  data jsonb NOT NULL DEFAULT '{}'::jsonb,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_group_events_feed ON group_events(group_id, created_at DESC, id DESC);
@@ -0,0 +1 @@
+ALTER TYPE group_metric RENAME VALUE 'PAGES' TO 'PAGES_SOON';
@@ -0,0 +1,2 @@
+-- PAGES deixou de ser "em breve"
+ALTER TYPE group_metric RENAME VALUE 'PAGES_SOON' TO 'PAGES';
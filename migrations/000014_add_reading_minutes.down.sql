@@ -0,0 +1,12 @@
+ALTER TYPE group_metric RENAME VALUE 'MINUTES' TO 'MINUTES_SOON';
+
+ALTER TABLE reading_goal DROP CONSTRAINT reading_goal_target_chk;
+ALTER TABLE reading_goal DROP COLUMN daily_minutes;
+DELETE FROM reading_goal WHERE daily_pages IS NULL;
+ALTER TABLE reading_goal ALTER COLUMN daily_pages SET NOT NULL;
+
+-- dias só com minutos não cabem no schema antigo
+DELETE FROM user_checkins WHERE pages_total = 0;
+ALTER TABLE user_checkins DROP CONSTRAINT user_checkins_amount_chk;
+ALTER TABLE user_checkins ADD CONSTRAINT reading_day_pages_total_chk CHECK (pages_total > 0);
+ALTER TABLE user_checkins DROP COLUMN minutes_total;
@@ -0,0 +1,16 @@
+-- Minutos de leitura/escuta (audiobook) ao lado das páginas
+ALTER TABLE user_checkins ADD COLUMN minutes_total INTEGER NOT NULL DEFAULT 0;
+
+-- Um dia pode ter só páginas, só minutos ou os dois
+ALTER TABLE user_checkins DROP CONSTRAINT reading_day_pages_total_chk;
+ALTER TABLE user_checkins ADD CONSTRAINT user_checkins_amount_chk
+  CHECK (pages_total >= 0 AND minutes_total >= 0 AND pages_total + minutes_total > 0);
+
+-- Meta diária em minutos; NULL = sem meta naquela unidade
+ALTER TABLE reading_goal ALTER COLUMN daily_pages DROP NOT NULL;
+ALTER TABLE reading_goal ADD COLUMN daily_minutes INTEGER CHECK (daily_minutes > 0 AND daily_minutes <= 1440);
+ALTER TABLE reading_goal ADD CONSTRAINT reading_goal_target_chk
+  CHECK (daily_pages IS NOT NULL OR daily_minutes IS NOT NULL);
+
+-- MINUTES deixou de ser "em breve"
+ALTER TYPE group_metric RENAME VALUE 'MINUTES_SOON' TO 'MINUTES';
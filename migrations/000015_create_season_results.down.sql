@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS season_results;
+DROP FUNCTION IF EXISTS season_results_immutable();
@@ -0,0 +1,33 @@
+-- Resultado congelado de cada participante quando a season termina.
+-- user_id não tem FK de propósito: excluir a conta não pode reescrever o pódio.
+CREATE TABLE season_results (
+  season_id uuid NOT NULL REFERENCES group_seasons(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL,
+  display_name text NOT NULL,
+  avatar_url text NOT NULL DEFAULT '',
+  rank int NOT NULL CHECK (rank > 0),
+  score int NOT NULL,
+  final_streak int NOT NULL,
+  longest_streak int NOT NULL,
+  checkin_days int NOT NULL,
+  best_day int NOT NULL,
+  last_checkin_date date NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+
+  PRIMARY KEY (season_id, user_id)
+);
+
+CREATE INDEX idx_season_results_rank ON season_results(season_id, rank, user_id);
+
+-- snapshot é só inserção
+CREATE OR REPLACE FUNCTION season_results_immutable()
+RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'season_results is immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_season_results_immutable
+  BEFORE UPDATE ON season_results
+  FOR EACH ROW
+  EXECUTE FUNCTION season_results_immutable();
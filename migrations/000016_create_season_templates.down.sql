@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_group_seasons_template_period;
+ALTER TABLE group_seasons DROP COLUMN IF EXISTS period_start;
+ALTER TABLE group_seasons DROP COLUMN IF EXISTS template_id;
+DROP TABLE IF EXISTS season_templates;
+DROP TYPE IF EXISTS season_period;
@@ -0,0 +1,31 @@
+-- Season recorrente: um template por grupo; o job agendado cria a próxima season quando a atual termina
+CREATE TYPE season_period AS ENUM ('WEEKLY', 'MONTHLY', 'DAYS');
+
+CREATE TABLE season_templates (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  group_id uuid NOT NULL UNIQUE REFERENCES groups(id) ON DELETE CASCADE,
+  metric group_metric NOT NULL,
+  timezone varchar NOT NULL,
+  period season_period NOT NULL,
+  period_days int NULL CHECK (period_days BETWEEN 1 AND 366),
+  auto_activate boolean NOT NULL DEFAULT false,
+  created_by_user_id uuid NOT NULL REFERENCES users(id),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+
+  CONSTRAINT season_templates_period_days_chk CHECK ((period = 'DAYS') = (period_days IS NOT NULL))
+);
+
+CREATE TRIGGER set_season_templates_updated_at
+  BEFORE UPDATE ON season_templates
+  FOR EACH ROW
+  EXECUTE FUNCTION set_updated_at();
+
+-- Seasons geradas guardam o template e o início do período: job repetido não duplica
+ALTER TABLE group_seasons
+  ADD COLUMN template_id uuid NULL REFERENCES season_templates(id) ON DELETE SET NULL,
+  ADD COLUMN period_start timestamptz NULL;
+
+CREATE UNIQUE INDEX idx_group_seasons_template_period
+  ON group_seasons(template_id, period_start)
+  WHERE template_id IS NOT NULL;
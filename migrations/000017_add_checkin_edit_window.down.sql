@@ -0,0 +1,2 @@
+ALTER TABLE user_checkins DROP COLUMN IF EXISTS logged_at;
+ALTER TABLE group_seasons DROP COLUMN IF EXISTS edit_window_minutes;
@@ -0,0 +1,8 @@
+-- Janela de correção do check-in volta como regra da season (0 = sem correção)
+ALTER TABLE group_seasons
+  ADD COLUMN edit_window_minutes smallint NOT NULL DEFAULT 15
+  CONSTRAINT group_seasons_edit_window_chk CHECK (edit_window_minutes BETWEEN 0 AND 1440);
+
+-- logged_at é o último registro de leitura do dia; correções não mexem nele (updated_at mexe)
+ALTER TABLE user_checkins ADD COLUMN logged_at timestamptz NOT NULL DEFAULT now();
+UPDATE user_checkins SET logged_at = updated_at;
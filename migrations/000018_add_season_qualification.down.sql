@@ -0,0 +1,4 @@
+ALTER TABLE group_seasons
+  DROP COLUMN IF EXISTS require_goal,
+  DROP COLUMN IF EXISTS min_minutes,
+  DROP COLUMN IF EXISTS min_pages;
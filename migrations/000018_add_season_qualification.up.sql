@@ -0,0 +1,7 @@
+-- Regras de qualificação: o dia só conta na season se bater os mínimos (0 = sem mínimo)
+ALTER TABLE group_seasons
+  ADD COLUMN min_pages smallint NOT NULL DEFAULT 0
+    CONSTRAINT group_seasons_min_pages_chk CHECK (min_pages BETWEEN 0 AND 500),
+  ADD COLUMN min_minutes smallint NOT NULL DEFAULT 0
+    CONSTRAINT group_seasons_min_minutes_chk CHECK (min_minutes BETWEEN 0 AND 1440),
+  ADD COLUMN require_goal boolean NOT NULL DEFAULT false;
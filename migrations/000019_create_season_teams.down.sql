@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS season_team_members;
+DROP TABLE IF EXISTS season_teams;
@@ -0,0 +1,18 @@
+-- Times de uma season: cada membro ativo do grupo fica em exatamente um time (validado no domínio)
+CREATE TABLE season_teams (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  season_id uuid NOT NULL REFERENCES group_seasons(id) ON DELETE CASCADE,
+  name text NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT season_teams_name_unique UNIQUE (season_id, name)
+);
+
+-- season_id repetido aqui pra PK garantir um time por membro na season
+CREATE TABLE season_team_members (
+  season_id uuid NOT NULL REFERENCES group_seasons(id) ON DELETE CASCADE,
+  team_id uuid NOT NULL REFERENCES season_teams(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  PRIMARY KEY (season_id, user_id)
+);
+
+CREATE INDEX idx_season_team_members_team ON season_team_members(team_id);
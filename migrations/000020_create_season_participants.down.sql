@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS season_participants;
+ALTER TABLE group_seasons DROP COLUMN IF EXISTS late_join_policy;
+DROP TYPE IF EXISTS season_late_join_policy;
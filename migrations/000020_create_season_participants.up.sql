@@ -0,0 +1,29 @@
+-- Política pra quem entra no grupo com a season em andamento
+CREATE TYPE season_late_join_policy AS ENUM ('EXCLUDED', 'FROM_JOIN_DATE', 'PRORATED');
+
+ALTER TABLE group_seasons
+  ADD COLUMN late_join_policy season_late_join_policy NOT NULL DEFAULT 'FROM_JOIN_DATE';
+
+-- Roster da season: congelado na ativação; late = entrou depois. Sair do grupo não apaga a linha.
+CREATE TABLE season_participants (
+  season_id uuid NOT NULL REFERENCES group_seasons(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  joined_at timestamptz NOT NULL,
+  late boolean NOT NULL DEFAULT false,
+  PRIMARY KEY (season_id, user_id)
+);
+
+CREATE INDEX idx_season_participants_user ON season_participants(user_id);
+
+-- Seasons já começadas: roster = membros ativos (só ACTIVE) + quem já tem check-in contado
+INSERT INTO season_participants (season_id, user_id, joined_at, late)
+SELECT s.id, m.user_id, s.started_at, false
+FROM group_seasons s
+JOIN group_members m ON m.group_id = s.group_id AND m.is_active
+WHERE s.status = 'ACTIVE' AND s.started_at IS NOT NULL
+UNION
+SELECT DISTINCT s.id, gc.user_id, s.started_at, false
+FROM group_checkins gc
+JOIN group_seasons s ON s.id = gc.season_id
+WHERE s.status <> 'DRAFT' AND s.started_at IS NOT NULL
+ON CONFLICT (season_id, user_id) DO NOTHING;
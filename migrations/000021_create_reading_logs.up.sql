@@ -0,0 +1,25 @@
+-- Cada registro de leitura; user_checkins guarda o total do dia (soma dos registros)
+CREATE TABLE reading_logs (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_checkin_id uuid NOT NULL REFERENCES user_checkins(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  local_date date NOT NULL,
+  pages int NOT NULL DEFAULT 0,
+  minutes int NOT NULL DEFAULT 0,
+  source varchar(32) NOT NULL,
+  logged_at timestamptz NOT NULL DEFAULT now(),
+
+  -- só a correção carrega diferença (pode ser negativa)
+  CONSTRAINT reading_logs_amount_chk CHECK (
+    source = 'correction' OR (pages >= 0 AND minutes >= 0 AND pages + minutes > 0)
+  )
+);
+
+CREATE INDEX idx_reading_logs_user_date ON reading_logs(user_id, local_date, logged_at);
+CREATE INDEX idx_reading_logs_user_checkin ON reading_logs(user_checkin_id);
+
+-- Dias anteriores viram um registro único com o total
+INSERT INTO reading_logs (user_checkin_id, user_id, local_date, pages, minutes, source, logged_at)
+SELECT id, user_id, local_date, pages_total, minutes_total, 'legacy', logged_at
+FROM user_checkins
+WHERE pages_total > 0 OR minutes_total > 0;
@@ -0,0 +1,15 @@
+ALTER TABLE group_checkins
+  DROP CONSTRAINT IF EXISTS group_checkins_season_id_user_checkin_id_key;
+
+-- A chave antiga não aceita dois dias pessoais na mesma data da season: fica o primeiro
+DELETE FROM group_checkins gc
+USING group_checkins older
+WHERE gc.group_id = older.group_id
+  AND gc.season_id = older.season_id
+  AND gc.user_id = older.user_id
+  AND gc.local_date = older.local_date
+  AND (older.created_at, older.id) < (gc.created_at, gc.id);
+
+ALTER TABLE group_checkins
+  ADD CONSTRAINT group_checkins_group_id_season_id_user_id_local_date_key
+  UNIQUE (group_id, season_id, user_id, local_date);
@@ -0,0 +1,17 @@
+-- Cada dia pessoal (user_checkins) conta uma vez por season, no dia da season calculado
+-- a partir do dia pessoal. Antes a chave era o dia local do registro, o que partia um dia
+-- em dois (registro depois da meia-noite da season) ou descartava um dia pessoal inteiro
+-- quando dois caíam na mesma data da season.
+
+-- Mantém só a primeira linha de cada dia pessoal por season
+DELETE FROM group_checkins gc
+USING group_checkins older
+WHERE gc.season_id = older.season_id
+  AND gc.user_checkin_id = older.user_checkin_id
+  AND (older.created_at, older.id) < (gc.created_at, gc.id);
+
+ALTER TABLE group_checkins
+  DROP CONSTRAINT group_checkins_group_id_season_id_user_id_local_date_key;
+
+ALTER TABLE group_checkins
+  ADD CONSTRAINT group_checkins_season_id_user_checkin_id_key UNIQUE (season_id, user_checkin_id);
@@ -0,0 +1,4 @@
+DELETE FROM group_checkins WHERE NOT qualified;
+
+ALTER TABLE group_checkins
+  DROP COLUMN IF EXISTS qualified;
@@ -0,0 +1,4 @@
+-- Correção que derruba o dia abaixo dos mínimos só desmarca o check-in: a linha fica pra
+-- correção seguinte poder reavaliar o dia mesmo depois de a season ter encerrado.
+ALTER TABLE group_checkins
+  ADD COLUMN qualified boolean NOT NULL DEFAULT true;
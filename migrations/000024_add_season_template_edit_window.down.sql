@@ -0,0 +1 @@
+ALTER TABLE season_templates DROP COLUMN IF EXISTS edit_window_minutes;
@@ -0,0 +1,4 @@
+-- Seasons geradas pelo template herdam a janela de correção (mesmo padrão de uma season nova)
+ALTER TABLE season_templates
+  ADD COLUMN edit_window_minutes smallint NOT NULL DEFAULT 15
+  CONSTRAINT season_templates_edit_window_chk CHECK (edit_window_minutes BETWEEN 0 AND 1440);
@@ -0,0 +1,7 @@
+-- Seasons geradas pelo template herdam as regras de qualificação (0 = sem mínimo)
+ALTER TABLE season_templates
+  ADD COLUMN min_pages smallint NOT NULL DEFAULT 0
+    CONSTRAINT season_templates_min_pages_chk CHECK (min_pages BETWEEN 0 AND 500),
+  ADD COLUMN min_minutes smallint NOT NULL DEFAULT 0
+    CONSTRAINT season_templates_min_minutes_chk CHECK (min_minutes BETWEEN 0 AND 1440),
+  ADD COLUMN require_goal boolean NOT NULL DEFAULT false;
@@ -0,0 +1 @@
+ALTER TABLE season_templates DROP COLUMN IF EXISTS late_join_policy;
@@ -0,0 +1,3 @@
+-- Seasons geradas pelo template herdam a política de entrada atrasada
+ALTER TABLE season_templates
+  ADD COLUMN late_join_policy season_late_join_policy NOT NULL DEFAULT 'FROM_JOIN_DATE';
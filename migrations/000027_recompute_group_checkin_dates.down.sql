@@ -0,0 +1,2 @@
+-- Sem volta: as datas anteriores não são recuperáveis e as novas continuam válidas.
+SELECT 1;
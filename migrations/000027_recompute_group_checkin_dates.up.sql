@@ -0,0 +1,10 @@
+-- Dia da season = dia pessoal, mas nunca depois do dia (no fuso da season) do primeiro
+-- registro (user_checkins.created_at). Recalcula as linhas gravadas com as regras anteriores;
+-- fuso que o Postgres não conhece (gravado antes da validação) fica como está.
+UPDATE group_checkins gc
+SET local_date = LEAST(uc.local_date, (uc.created_at AT TIME ZONE s.timezone)::date)
+FROM user_checkins uc, group_seasons s
+WHERE uc.id = gc.user_checkin_id
+  AND s.id = gc.season_id
+  AND s.timezone IN (SELECT name FROM pg_timezone_names)
+  AND gc.local_date <> LEAST(uc.local_date, (uc.created_at AT TIME ZONE s.timezone)::date);
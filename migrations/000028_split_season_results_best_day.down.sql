@@ -0,0 +1,14 @@
+ALTER TABLE season_results ADD COLUMN best_day int NOT NULL DEFAULT 0;
+
+ALTER TABLE season_results DISABLE TRIGGER trg_season_results_immutable;
+
+UPDATE season_results r
+SET best_day = CASE WHEN s.metric = 'MINUTES' THEN r.best_day_minutes ELSE r.best_day_pages END
+FROM group_seasons s
+WHERE s.id = r.season_id;
+
+ALTER TABLE season_results ENABLE TRIGGER trg_season_results_immutable;
+
+ALTER TABLE season_results
+  DROP COLUMN best_day_pages,
+  DROP COLUMN best_day_minutes;
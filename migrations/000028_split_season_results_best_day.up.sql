@@ -0,0 +1,19 @@
+-- Maior dia separado por unidade: quem só registra minutos também aparece no destaque.
+-- best_day era na unidade da métrica (páginas em CHECKINS_PER_DAY), então os snapshots
+-- antigos só preenchem essa unidade.
+ALTER TABLE season_results
+  ADD COLUMN best_day_pages int NOT NULL DEFAULT 0,
+  ADD COLUMN best_day_minutes int NOT NULL DEFAULT 0;
+
+-- snapshot é imutável; a cópia da coluna antiga é a única exceção
+ALTER TABLE season_results DISABLE TRIGGER trg_season_results_immutable;
+
+UPDATE season_results r
+SET best_day_pages = CASE WHEN s.metric = 'MINUTES' THEN 0 ELSE r.best_day END,
+    best_day_minutes = CASE WHEN s.metric = 'MINUTES' THEN r.best_day ELSE 0 END
+FROM group_seasons s
+WHERE s.id = r.season_id;
+
+ALTER TABLE season_results ENABLE TRIGGER trg_season_results_immutable;
+
+ALTER TABLE season_results DROP COLUMN best_day;